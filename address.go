@@ -0,0 +1,102 @@
+package cfdgo
+
+import (
+	"errors"
+)
+
+/**
+ * Address prefix struct.
+ */
+type addressPrefix struct {
+	networkType  NetworkType
+	p2pkh        byte
+	p2sh         byte
+	isElements   bool
+	confidential byte
+}
+
+/**
+ * Get address prefix list of the default networks and the registered custom network.
+ * detail: the default networks are prior to the custom network.
+ * return: prefixes    address prefix list
+ */
+func getAddressPrefixes() []addressPrefix {
+	prefixes := []addressPrefix{
+		{networkType: KCfdNetworkMainnet, p2pkh: 0x00, p2sh: 0x05},
+		{networkType: KCfdNetworkTestnet, p2pkh: 0x6f, p2sh: 0xc4},
+		{networkType: KCfdNetworkRegtest, p2pkh: 0x6f, p2sh: 0xc4},
+		{networkType: KCfdNetworkLiquidv1, p2pkh: 0x39, p2sh: 0x27, isElements: true, confidential: 0x0c},
+		{networkType: KCfdNetworkElementsRegtest, p2pkh: 0xeb, p2sh: 0x4b, isElements: true, confidential: 0x04},
+	}
+	if params := getCustomNetworkParams(); params != nil {
+		prefixes = append(prefixes, addressPrefix{networkType: KCfdNetworkCustomChain,
+			p2pkh: params.P2pkhPrefix, p2sh: params.P2shPrefix,
+			isElements: params.IsElements, confidential: params.ConfidentialPrefix})
+	}
+	return prefixes
+}
+
+/**
+ * Get address prefix of the network.
+ * param: networkType  network type
+ * return: prefix      address prefix
+ * return: err         error
+ */
+func getAddressPrefix(networkType NetworkType) (prefix *addressPrefix, err error) {
+	for _, data := range getAddressPrefixes() {
+		if data.networkType == networkType {
+			return &data, nil
+		}
+	}
+	return nil, errors.New("Illegal network type.")
+}
+
+/**
+ * Address data struct.
+ */
+type CfdAddressData struct {
+	// address string
+	Address string
+	// network type
+	NetworkType NetworkType
+	// hash type (p2pkh, p2sh, p2wpkh, p2wsh, taproot)
+	HashType HashType
+	// witness version (-1 if not segwit address)
+	WitnessVersion int
+	// pubkey hash or script hash hex (witness program if segwit address)
+	Hash string
+	// locking script hex
+	LockingScript string
+	// unconfidential address (confidential address only)
+	UnconfidentialAddress string
+	// confidential key (confidential address only)
+	ConfidentialKey string
+}
+
+/**
+ * Validate address of the network.
+ * detail: base58 address of testnet and regtest is the same format.
+ * param: handle       cfd handle
+ * param: address      address string (bitcoin, elements or confidential address)
+ * param: networkType  expected network type
+ * return: data        address data
+ * return: err         error
+ */
+func CfdGoValidateAddress(handle uintptr, address string, networkType NetworkType) (data CfdAddressData, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		return data, convertGoError(err, "CfdGoValidateAddress")
+	}
+	if data, err = CfdGoGetAddressInfo(handle, address); err != nil {
+		return CfdAddressData{}, err
+	}
+	if data.NetworkType != networkType {
+		isBitcoinTestnet := func(networkType NetworkType) bool {
+			return networkType == KCfdNetworkTestnet || networkType == KCfdNetworkRegtest
+		}
+		if data.WitnessVersion >= 0 || !isBitcoinTestnet(data.NetworkType) || !isBitcoinTestnet(networkType) {
+			return CfdAddressData{}, convertGoError(errors.New("Address network mismatch."), "CfdGoValidateAddress")
+		}
+		data.NetworkType = networkType
+	}
+	return data, nil
+}
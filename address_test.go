@@ -0,0 +1,229 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdGetAddressInfo(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	testVectors := []struct {
+		address        string
+		networkType    NetworkType
+		hashType       HashType
+		witnessVersion int
+		hash           string
+		lockingScript  string
+	}{
+		{"1JtK9CQw1syfWj1WtFMWomrYdV3W2tWBF9", KCfdNetworkMainnet, KCfdP2pkh, -1,
+			"c42e7ef92fdb603af844d064faad95db9bcdfd3d", "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac"},
+		{"myQGSFVupuQvHqV8bpKtdh4sVUeCyyxs6M", KCfdNetworkTestnet, KCfdP2pkh, -1,
+			"c42e7ef92fdb603af844d064faad95db9bcdfd3d", "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac"},
+		{"bcrt1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfacs66tf", KCfdNetworkRegtest, KCfdP2wpkh, 0,
+			"c42e7ef92fdb603af844d064faad95db9bcdfd3d", "0014c42e7ef92fdb603af844d064faad95db9bcdfd3d"},
+		{"bc1qvjtfmrxu524qhdevl6yyyasjs7xmnzjlqlu60mrwepact60eyz9s9xjw0c", KCfdNetworkMainnet, KCfdP2wsh, 0,
+			"64969d8cdca2aa0bb72cfe88427612878db98a5f07f9a7ec6ec87b85e9f9208b", "002064969d8cdca2aa0bb72cfe88427612878db98a5f07f9a7ec6ec87b85e9f9208b"},
+		{"PwsjpD1YkjcfZ95WGVZuvGfypkKmpogoA3", KCfdNetworkLiquidv1, KCfdP2pkh, -1,
+			"06afd46bcdfd22ef94ac122aa11f241244a37ecc", "76a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac"},
+		{"Gq1mmExLuSEwfzzk6YtUxJ769grv6T5Tak", KCfdNetworkLiquidv1, KCfdP2sh, -1,
+			"55e8d5e8ee4f3604aba23c71c2684fa0a56a3a12", "a91455e8d5e8ee4f3604aba23c71c2684fa0a56a3a1287"},
+		{"ex1ql3dvcvp24wtlsg0e5c0pe3tju7tg5cp428546jap9dga7evpfqhs0htdlf", KCfdNetworkLiquidv1, KCfdP2wsh, 0,
+			"fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", "0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f"},
+	}
+	for _, vector := range testVectors {
+		data, err := CfdGoGetAddressInfo(handle, vector.address)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, data.Address)
+		assert.Equal(t, vector.networkType, data.NetworkType)
+		assert.Equal(t, vector.hashType, data.HashType)
+		assert.Equal(t, vector.witnessVersion, data.WitnessVersion)
+		assert.Equal(t, vector.hash, data.Hash)
+		assert.Equal(t, vector.lockingScript, data.LockingScript)
+		assert.Equal(t, "", data.ConfidentialKey)
+
+		data, err = CfdGoValidateAddress(handle, vector.address, vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.networkType, data.NetworkType)
+	}
+
+	// base58 address of regtest
+	data, err := CfdGoValidateAddress(handle, "myQGSFVupuQvHqV8bpKtdh4sVUeCyyxs6M", KCfdNetworkRegtest)
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdNetworkRegtest, data.NetworkType)
+
+	// network mismatch
+	_, err = CfdGoValidateAddress(handle, "bcrt1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfacs66tf", KCfdNetworkTestnet)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Address network mismatch.")
+	_, err = CfdGoValidateAddress(handle, "PwsjpD1YkjcfZ95WGVZuvGfypkKmpogoA3", KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Address network mismatch.")
+	_, err = CfdGoValidateAddress(handle, "1JtK9CQw1syfWj1WtFMWomrYdV3W2tWBF9", KCfdNetworkCustomChain)
+	assert.Contains(t, err.Error(), "Address network mismatch.")
+
+	// invalid address
+	_, err = CfdGoGetAddressInfo(handle, "1JtK9CQw1syfWj1WtFMWomrYdV3W2tWBF8")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGetAddressInfo test done.\n")
+}
+
+func TestCfdGetConfidentialAddressInfo(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	confidentialAddress := "VTpvKKc1SNmLG4H8CnR1fGJdHdyWGEQEvdP9gfeneJR7n81S5kiwNtgF7vrZjC8mp63HvwxM81nEbTxU"
+	data, err := CfdGoValidateAddress(handle, confidentialAddress, KCfdNetworkLiquidv1)
+	assert.NoError(t, err)
+	assert.Equal(t, confidentialAddress, data.Address)
+	assert.Equal(t, "Q7wegLt2qMGhm28vch6VTzvpzs8KXvs4X7", data.UnconfidentialAddress)
+	assert.Equal(t, "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357", data.ConfidentialKey)
+	assert.Equal(t, KCfdNetworkLiquidv1, data.NetworkType)
+	assert.Equal(t, KCfdP2pkh, data.HashType)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGetConfidentialAddressInfo test done.\n")
+}
+
+func TestCfdGetAddressFromLockingScript(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	testVectors := []struct {
+		lockingScript string
+		networkType   NetworkType
+		address       string
+	}{
+		{"76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", KCfdNetworkMainnet, "1JtK9CQw1syfWj1WtFMWomrYdV3W2tWBF9"},
+		{"76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", KCfdNetworkRegtest, "myQGSFVupuQvHqV8bpKtdh4sVUeCyyxs6M"},
+		{"0014c42e7ef92fdb603af844d064faad95db9bcdfd3d", KCfdNetworkRegtest, "bcrt1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfacs66tf"},
+		{"76a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac", KCfdNetworkLiquidv1, "PwsjpD1YkjcfZ95WGVZuvGfypkKmpogoA3"},
+		{"a91455e8d5e8ee4f3604aba23c71c2684fa0a56a3a1287", KCfdNetworkLiquidv1, "Gq1mmExLuSEwfzzk6YtUxJ769grv6T5Tak"},
+		{"0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", KCfdNetworkLiquidv1, "ex1ql3dvcvp24wtlsg0e5c0pe3tju7tg5cp428546jap9dga7evpfqhs0htdlf"},
+		{"76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", KCfdNetworkElementsRegtest, "2dsK4XiTdsGsZhamsdqdQmEpRY2bB7k4ebH"},
+		{"a91455e8d5e8ee4f3604aba23c71c2684fa0a56a3a1287", KCfdNetworkElementsRegtest, "XKBVD9giTvvV7c1ryesxQouQor8siQZfYG"},
+		{"0014c42e7ef92fdb603af844d064faad95db9bcdfd3d", KCfdNetworkElementsRegtest, "ert1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfaqy0y4f"},
+		{"0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", KCfdNetworkElementsRegtest, "ert1ql3dvcvp24wtlsg0e5c0pe3tju7tg5cp428546jap9dga7evpfqhsc650e7"},
+	}
+	for _, vector := range testVectors {
+		address, err := CfdGoGetAddressFromLockingScript(handle, vector.lockingScript, vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+
+		data, err := CfdGoValidateAddress(handle, address, vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.lockingScript, data.LockingScript)
+	}
+
+	// error
+	_, err = CfdGoGetAddressFromLockingScript(handle, "6a0401020304", KCfdNetworkMainnet)
+	assert.Error(t, err)
+	_, err = CfdGoGetAddressFromLockingScript(handle, "21025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357ac", KCfdNetworkMainnet)
+	assert.Error(t, err)
+	_, err = CfdGoGetAddressFromLockingScript(handle, "0014zz", KCfdNetworkMainnet)
+	assert.Error(t, err)
+	_, err = CfdGoGetAddressFromLockingScript(handle, "0014c42e7ef92fdb603af844d064faad95db9bcdfd3d", KCfdNetworkCustomChain)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGetAddressFromLockingScript test done.\n")
+}
+
+func TestCfdTaprootAddress(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	pubkey := "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	lockingScript := "5120" + pubkey
+	testVectors := []struct {
+		networkType NetworkType
+		address     string
+	}{
+		{KCfdNetworkMainnet, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"},
+		{KCfdNetworkLiquidv1, "ex1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq7el8jf"},
+		{KCfdNetworkElementsRegtest, "ert1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqf5q957"},
+	}
+	for _, vector := range testVectors {
+		address, script, p2shSegwitLockingScript, err := CfdGoCreateAddress(handle, KCfdTaproot, pubkey, "", vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+		assert.Equal(t, lockingScript, script)
+		assert.Equal(t, "", p2shSegwitLockingScript)
+
+		data, err := CfdGoValidateAddress(handle, vector.address, vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, KCfdTaproot, data.HashType)
+		assert.Equal(t, 1, data.WitnessVersion)
+		assert.Equal(t, pubkey, data.Hash)
+		assert.Equal(t, lockingScript, data.LockingScript)
+
+		address, err = CfdGoGetAddressFromLockingScript(handle, lockingScript, vector.networkType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+	}
+
+	// bech32m (BIP350)
+	data, err := CfdGoGetAddressInfo(handle, "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdNetworkTestnet, data.NetworkType)
+	assert.Equal(t, "5120000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433", data.LockingScript)
+	// witness version 1 with bech32, version 0 with bech32m
+	_, err = CfdGoGetAddressInfo(handle, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd")
+	assert.Error(t, err)
+	_, err = CfdGoGetAddressInfo(handle, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh")
+	assert.Error(t, err)
+	_, _, _, err = CfdGoCreateAddress(handle, KCfdTaproot, "02"+pubkey, "", KCfdNetworkMainnet)
+	assert.Error(t, err)
+
+	// blech32m
+	confidentialKey := "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"
+	confidentialAddress, err := CfdGoCreateConfidentialAddress(handle, "ert1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqf5q957", confidentialKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "el1pqf28dshgxxyrdrdplulzjtn6et7dkdtxhv9dy5lk9lrs7pawae34w7d7vel0nh9m4326qc54e6rskpczn07dktww9rv4nu5ptvt0s9ucqpuvh9vsk406", confidentialAddress)
+	address, key, networkType, err := CfdGoParseConfidentialAddress(handle, confidentialAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, "ert1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqf5q957", address)
+	assert.Equal(t, confidentialKey, key)
+	assert.Equal(t, KCfdNetworkElementsRegtest, networkType)
+
+	confidentialAddress, err = CfdGoCreateConfidentialAddress(handle, "ex1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq7el8jf", confidentialKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "lq1pqf28dshgxxyrdrdplulzjtn6et7dkdtxhv9dy5lk9lrs7pawae34w7d7vel0nh9m4326qc54e6rskpczn07dktww9rv4nu5ptvt0s9ucfdzxtn0q49c2", confidentialAddress)
+	data, err = CfdGoValidateAddress(handle, confidentialAddress, KCfdNetworkLiquidv1)
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdTaproot, data.HashType)
+	assert.Equal(t, "ex1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq7el8jf", data.UnconfidentialAddress)
+	assert.Equal(t, confidentialKey, data.ConfidentialKey)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdTaprootAddress test done.\n")
+}
+
+func TestCfdConfidentialSegwitAddress(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	// blech32 (witness version 0)
+	confidentialKey := "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"
+	confidentialAddress, err := CfdGoCreateConfidentialAddress(handle, "ert1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfaqy0y4f", confidentialKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "el1qqf28dshgxxyrdrdplulzjtn6et7dkdtxhv9dy5lk9lrs7pawae3403pw0mujlkmq8tuyf5ryl2ketkumeh7n6q558haq8slnu", confidentialAddress)
+
+	address, key, networkType, err := CfdGoParseConfidentialAddress(handle, confidentialAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, "ert1qcsh8a7f0mdsr47zy6pj04tv4mwdumlfaqy0y4f", address)
+	assert.Equal(t, confidentialKey, key)
+	assert.Equal(t, KCfdNetworkElementsRegtest, networkType)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdConfidentialSegwitAddress test done.\n")
+}
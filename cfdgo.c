@@ -371,6 +371,18 @@ intgo _wrap_kCfdDiskAccessError_cfdgo_a23e02774b82509b() {
 }
 
 
+intgo _wrap_kCfdSignVerificationError_cfdgo_a23e02774b82509b() {
+  enum CfdErrorCode result;
+  intgo _swig_go_result;
+  
+  
+  result = kCfdSignVerificationError;
+  
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_kCfdEnableBitcoin_cfdgo_a23e02774b82509b() {
   enum CfdLibraryFunction result;
   intgo _swig_go_result;
@@ -1264,6 +1276,35 @@ intgo _wrap_CfdFreeDescriptorHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, voi
 }
 
 
+intgo _wrap_CfdGetDescriptorChecksum_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  int arg2 ;
+  char *arg3 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetDescriptorChecksum(arg1,arg2,(char const *)arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_CfdGetAddressesFromMultisig_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, intgo _swig_go_3, void **_swig_go_4, uint32_t *_swig_go_5) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
@@ -1345,6 +1386,75 @@ intgo _wrap_CfdFreeAddressesMultisigHandle_cfdgo_a23e02774b82509b(void *_swig_go
 }
 
 
+intgo _wrap_CfdGetAddressFromLockingScript_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetAddressFromLockingScript(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetAddressInfo_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo *_swig_go_2, intgo *_swig_go_3, intgo *_swig_go_4, _gostring_* _swig_go_5, _gostring_* _swig_go_6) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int *arg3 = (int *) 0 ;
+  int *arg4 = (int *) 0 ;
+  int *arg5 = (int *) 0 ;
+  char **arg6 = (char **) 0 ;
+  char **arg7 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(int **)&_swig_go_2; 
+  arg4 = *(int **)&_swig_go_3; 
+  arg5 = *(int **)&_swig_go_4; 
+  arg6 = *(char ***)&_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  
+  result = (int)CfdGetAddressInfo(arg1,(char const *)arg2,arg3,arg4,arg5,arg6,arg7);
+  _swig_go_result = result; 
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_CfdCreateConfidentialAddress_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
@@ -1415,6 +1525,114 @@ intgo _wrap_CfdParseConfidentialAddress_cfdgo_a23e02774b82509b(void *_swig_go_0,
 }
 
 
+intgo _wrap_CfdGetPeginAddress_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, _gostring_ _swig_go_2, intgo _swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7, _gostring_* _swig_go_8) {
+  void *arg1 = (void *) 0 ;
+  int arg2 ;
+  char *arg3 = (char *) 0 ;
+  int arg4 ;
+  char *arg5 = (char *) 0 ;
+  char *arg6 = (char *) 0 ;
+  char **arg7 = (char **) 0 ;
+  char **arg8 = (char **) 0 ;
+  char **arg9 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = (int)_swig_go_3; 
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  arg9 = *(char ***)&_swig_go_8; 
+  
+  result = (int)CfdGetPeginAddress(arg1,arg2,(char const *)arg3,arg4,(char const *)arg5,(char const *)arg6,arg7,arg8,arg9);
+  _swig_go_result = result; 
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  {
+    if (arg9 && *arg9) {
+      _swig_go_8->n = strlen(*arg9);
+    }
+  }
+  free(arg3); 
+  free(arg5); 
+  free(arg6); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetPegoutAddress_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, _gostring_ _swig_go_3, uint32_t *_swig_go_4, intgo _swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7) {
+  void *arg1 = (void *) 0 ;
+  int arg2 ;
+  int arg3 ;
+  char *arg4 = (char *) 0 ;
+  uint32_t arg5 ;
+  int arg6 ;
+  char **arg7 = (char **) 0 ;
+  char **arg8 = (char **) 0 ;
+  uint32_t *argp5 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg5 = (uint32_t)*argp5;
+  
+  arg6 = (int)_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  
+  result = (int)CfdGetPegoutAddress(arg1,arg2,arg3,(char const *)arg4,arg5,arg6,arg7,arg8);
+  _swig_go_result = result; 
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_CfdInitializeConfidentialTx_cfdgo_a23e02774b82509b(void *_swig_go_0, uint32_t *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3) {
   void *arg1 = (void *) 0 ;
   uint32_t arg2 ;
@@ -1656,6 +1874,57 @@ intgo _wrap_CfdUpdateConfidentialTxOut_cfdgo_a23e02774b82509b(void *_swig_go_0,
 }
 
 
+intgo _wrap_CfdGetConfidentialTxInfo_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, uint32_t *_swig_go_5, uint32_t *_swig_go_6, uint32_t *_swig_go_7, uint32_t *_swig_go_8, uint32_t *_swig_go_9) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  uint32_t *arg6 = (uint32_t *) 0 ;
+  uint32_t *arg7 = (uint32_t *) 0 ;
+  uint32_t *arg8 = (uint32_t *) 0 ;
+  uint32_t *arg9 = (uint32_t *) 0 ;
+  uint32_t *arg10 = (uint32_t *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(uint32_t **)&_swig_go_5; 
+  arg7 = *(uint32_t **)&_swig_go_6; 
+  arg8 = *(uint32_t **)&_swig_go_7; 
+  arg9 = *(uint32_t **)&_swig_go_8; 
+  arg10 = *(uint32_t **)&_swig_go_9; 
+  
+  result = (int)CfdGetConfidentialTxInfo(arg1,(char const *)arg2,arg3,arg4,arg5,arg6,arg7,arg8,arg9,arg10);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_CfdGetConfidentialTxIn_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3, uint32_t *_swig_go_4, uint32_t *_swig_go_5, _gostring_* _swig_go_6) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
@@ -1971,231 +2240,99 @@ intgo _wrap_CfdGetConfidentialTxOutCount_cfdgo_a23e02774b82509b(void *_swig_go_0
 }
 
 
-intgo _wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, int64_t *_swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, _gostring_ _swig_go_8, _gostring_* _swig_go_9, _gostring_* _swig_go_10) {
+intgo _wrap_CfdInitializeTxDataHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, _gostring_ _swig_go_2, void **_swig_go_3) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
+  int arg2 ;
   char *arg3 = (char *) 0 ;
-  uint32_t arg4 ;
-  int64_t arg5 ;
-  char *arg6 = (char *) 0 ;
-  char *arg7 = (char *) 0 ;
-  char *arg8 = (char *) 0 ;
-  char *arg9 = (char *) 0 ;
-  char **arg10 = (char **) 0 ;
-  char **arg11 = (char **) 0 ;
-  uint32_t *argp4 ;
-  int64_t *argp5 ;
-  int result;
-  intgo _swig_go_result;
-  
-  arg1 = *(void **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
-  
-  
-  argp4 = (uint32_t *)_swig_go_3;
-  if (argp4 == NULL) {
-    _swig_gopanic("Attempt to dereference null uint32_t");
-  }
-  arg4 = (uint32_t)*argp4;
-  
-  
-  argp5 = (int64_t *)_swig_go_4;
-  if (argp5 == NULL) {
-    _swig_gopanic("Attempt to dereference null int64_t");
-  }
-  arg5 = (int64_t)*argp5;
-  
-  
-  arg6 = (char *)malloc(_swig_go_5.n + 1);
-  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
-  arg6[_swig_go_5.n] = '\0';
-  
-  
-  arg7 = (char *)malloc(_swig_go_6.n + 1);
-  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
-  arg7[_swig_go_6.n] = '\0';
-  
-  
-  arg8 = (char *)malloc(_swig_go_7.n + 1);
-  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
-  arg8[_swig_go_7.n] = '\0';
-  
-  
-  arg9 = (char *)malloc(_swig_go_8.n + 1);
-  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
-  arg9[_swig_go_8.n] = '\0';
-  
-  arg10 = *(char ***)&_swig_go_9; 
-  arg11 = *(char ***)&_swig_go_10; 
-  
-  result = (int)CfdSetRawReissueAsset(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,(char const *)arg7,(char const *)arg8,(char const *)arg9,arg10,arg11);
-  _swig_go_result = result; 
-  {
-    if (arg10 && *arg10) {
-      _swig_go_9->n = strlen(*arg10);
-    }
-  }
-  {
-    if (arg11 && *arg11) {
-      _swig_go_10->n = strlen(*arg11);
-    }
-  }
-  free(arg2); 
-  free(arg3); 
-  free(arg6); 
-  free(arg7); 
-  free(arg8); 
-  free(arg9); 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_* _swig_go_4) {
-  void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
-  uint32_t arg4 ;
-  char **arg5 = (char **) 0 ;
-  uint32_t *argp4 ;
+  void **arg4 = (void **) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
+  arg2 = (int)_swig_go_1; 
   
   arg3 = (char *)malloc(_swig_go_2.n + 1);
   memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
   arg3[_swig_go_2.n] = '\0';
   
+  arg4 = *(void ***)&_swig_go_3; 
   
-  argp4 = (uint32_t *)_swig_go_3;
-  if (argp4 == NULL) {
-    _swig_gopanic("Attempt to dereference null uint32_t");
-  }
-  arg4 = (uint32_t)*argp4;
-  
-  arg5 = *(char ***)&_swig_go_4; 
-  
-  result = (int)CfdGetIssuanceBlindingKey(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5);
+  result = (int)CfdInitializeTxDataHandle(arg1,arg2,(char const *)arg3,arg4);
   _swig_go_result = result; 
-  {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
-    }
-  }
-  free(arg2); 
   free(arg3); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdInitializeBlindTx_cfdgo_a23e02774b82509b(void *_swig_go_0, void **_swig_go_1) {
+intgo _wrap_CfdFreeTxDataHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
   void *arg1 = (void *) 0 ;
-  void **arg2 = (void **) 0 ;
+  void *arg2 = (void *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  arg2 = *(void ***)&_swig_go_1; 
+  arg2 = *(void **)&_swig_go_1; 
   
-  result = (int)CfdInitializeBlindTx(arg1,arg2);
+  result = (int)CfdFreeTxDataHandle(arg1,arg2);
   _swig_go_result = result; 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, int64_t *_swig_go_7, _gostring_ _swig_go_8, _gostring_ _swig_go_9) {
+intgo _wrap_CfdGetConfidentialTxInfoByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_* _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, uint32_t *_swig_go_5, uint32_t *_swig_go_6, uint32_t *_swig_go_7, uint32_t *_swig_go_8, uint32_t *_swig_go_9) {
   void *arg1 = (void *) 0 ;
   void *arg2 = (void *) 0 ;
-  char *arg3 = (char *) 0 ;
-  uint32_t arg4 ;
-  char *arg5 = (char *) 0 ;
-  char *arg6 = (char *) 0 ;
-  char *arg7 = (char *) 0 ;
-  int64_t arg8 ;
-  char *arg9 = (char *) 0 ;
-  char *arg10 = (char *) 0 ;
-  uint32_t *argp4 ;
-  int64_t *argp8 ;
+  char **arg3 = (char **) 0 ;
+  char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  uint32_t *arg6 = (uint32_t *) 0 ;
+  uint32_t *arg7 = (uint32_t *) 0 ;
+  uint32_t *arg8 = (uint32_t *) 0 ;
+  uint32_t *arg9 = (uint32_t *) 0 ;
+  uint32_t *arg10 = (uint32_t *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
   arg2 = *(void **)&_swig_go_1; 
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(uint32_t **)&_swig_go_5; 
+  arg7 = *(uint32_t **)&_swig_go_6; 
+  arg8 = *(uint32_t **)&_swig_go_7; 
+  arg9 = *(uint32_t **)&_swig_go_8; 
+  arg10 = *(uint32_t **)&_swig_go_9; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
-  
-  
-  argp4 = (uint32_t *)_swig_go_3;
-  if (argp4 == NULL) {
-    _swig_gopanic("Attempt to dereference null uint32_t");
+  result = (int)CfdGetConfidentialTxInfoByHandle(arg1,arg2,arg3,arg4,arg5,arg6,arg7,arg8,arg9,arg10);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
   }
-  arg4 = (uint32_t)*argp4;
-  
-  
-  arg5 = (char *)malloc(_swig_go_4.n + 1);
-  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
-  arg5[_swig_go_4.n] = '\0';
-  
-  
-  arg6 = (char *)malloc(_swig_go_5.n + 1);
-  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
-  arg6[_swig_go_5.n] = '\0';
-  
-  
-  arg7 = (char *)malloc(_swig_go_6.n + 1);
-  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
-  arg7[_swig_go_6.n] = '\0';
-  
-  
-  argp8 = (int64_t *)_swig_go_7;
-  if (argp8 == NULL) {
-    _swig_gopanic("Attempt to dereference null int64_t");
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
   }
-  arg8 = (int64_t)*argp8;
-  
-  
-  arg9 = (char *)malloc(_swig_go_8.n + 1);
-  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
-  arg9[_swig_go_8.n] = '\0';
-  
-  
-  arg10 = (char *)malloc(_swig_go_9.n + 1);
-  memcpy(arg10, _swig_go_9.p, _swig_go_9.n);
-  arg10[_swig_go_9.n] = '\0';
-  
-  
-  result = (int)CfdAddBlindTxInData(arg1,arg2,(char const *)arg3,arg4,(char const *)arg5,(char const *)arg6,(char const *)arg7,arg8,(char const *)arg9,(char const *)arg10);
-  _swig_go_result = result; 
-  free(arg3); 
-  free(arg5); 
-  free(arg6); 
-  free(arg7); 
-  free(arg9); 
-  free(arg10); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3) {
+intgo _wrap_CfdGetTxInByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3, uint32_t *_swig_go_4, uint32_t *_swig_go_5, _gostring_* _swig_go_6) {
   void *arg1 = (void *) 0 ;
   void *arg2 = (void *) 0 ;
   uint32_t arg3 ;
-  char *arg4 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  uint32_t *arg5 = (uint32_t *) 0 ;
+  uint32_t *arg6 = (uint32_t *) 0 ;
+  char **arg7 = (char **) 0 ;
   uint32_t *argp3 ;
   int result;
   intgo _swig_go_result;
@@ -2209,87 +2346,112 @@ intgo _wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(void *_swig_go_0, void *
   }
   arg3 = (uint32_t)*argp3;
   
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(uint32_t **)&_swig_go_4; 
+  arg6 = *(uint32_t **)&_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
   
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
-  
-  
-  result = (int)CfdAddBlindTxOutData(arg1,arg2,arg3,(char const *)arg4);
+  result = (int)CfdGetTxInByHandle(arg1,arg2,arg3,arg4,arg5,arg6,arg7);
   _swig_go_result = result; 
-  free(arg4); 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
+intgo _wrap_CfdGetTxInIssuanceInfoByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, int64_t *_swig_go_5, _gostring_* _swig_go_6, int64_t *_swig_go_7, _gostring_* _swig_go_8, _gostring_* _swig_go_9, _gostring_* _swig_go_10) {
   void *arg1 = (void *) 0 ;
   void *arg2 = (void *) 0 ;
-  char *arg3 = (char *) 0 ;
+  uint32_t arg3 ;
   char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  int64_t *arg6 = (int64_t *) 0 ;
+  char **arg7 = (char **) 0 ;
+  int64_t *arg8 = (int64_t *) 0 ;
+  char **arg9 = (char **) 0 ;
+  char **arg10 = (char **) 0 ;
+  char **arg11 = (char **) 0 ;
+  uint32_t *argp3 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
   arg2 = *(void **)&_swig_go_1; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
   
   arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(int64_t **)&_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(int64_t **)&_swig_go_7; 
+  arg9 = *(char ***)&_swig_go_8; 
+  arg10 = *(char ***)&_swig_go_9; 
+  arg11 = *(char ***)&_swig_go_10; 
   
-  result = (int)CfdFinalizeBlindTx(arg1,arg2,(char const *)arg3,arg4);
+  result = (int)CfdGetTxInIssuanceInfoByHandle(arg1,arg2,arg3,arg4,arg5,arg6,arg7,arg8,arg9,arg10,arg11);
   _swig_go_result = result; 
   {
     if (arg4 && *arg4) {
       _swig_go_3->n = strlen(*arg4);
     }
   }
-  free(arg3); 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_CfdFreeBlindHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
-  void *arg1 = (void *) 0 ;
-  void *arg2 = (void *) 0 ;
-  int result;
-  intgo _swig_go_result;
-  
-  arg1 = *(void **)&_swig_go_0; 
-  arg2 = *(void **)&_swig_go_1; 
-  
-  result = (int)CfdFreeBlindHandle(arg1,arg2);
-  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg9 && *arg9) {
+      _swig_go_8->n = strlen(*arg9);
+    }
+  }
+  {
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
+    }
+  }
+  {
+    if (arg11 && *arg11) {
+      _swig_go_10->n = strlen(*arg11);
+    }
+  }
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, bool _swig_go_4, _gostring_ _swig_go_5, bool _swig_go_6, _gostring_* _swig_go_7) {
+intgo _wrap_CfdGetTxInWitnessByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, intgo _swig_go_2, uint32_t *_swig_go_3, uint32_t *_swig_go_4, _gostring_* _swig_go_5) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int arg3 ;
   uint32_t arg4 ;
-  bool arg5 ;
-  char *arg6 = (char *) 0 ;
-  bool arg7 ;
-  char **arg8 = (char **) 0 ;
+  uint32_t arg5 ;
+  char **arg6 = (char **) 0 ;
   uint32_t *argp4 ;
+  uint32_t *argp5 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
-  
+  arg2 = *(void **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
   
   argp4 = (uint32_t *)_swig_go_3;
   if (argp4 == NULL) {
@@ -2297,174 +2459,168 @@ intgo _wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _g
   }
   arg4 = (uint32_t)*argp4;
   
-  arg5 = (bool)_swig_go_4; 
   
-  arg6 = (char *)malloc(_swig_go_5.n + 1);
-  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
-  arg6[_swig_go_5.n] = '\0';
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg5 = (uint32_t)*argp5;
   
-  arg7 = (bool)_swig_go_6; 
-  arg8 = *(char ***)&_swig_go_7; 
+  arg6 = *(char ***)&_swig_go_5; 
   
-  result = (int)CfdAddConfidentialTxSign(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,arg7,arg8);
+  result = (int)CfdGetTxInWitnessByHandle(arg1,arg2,arg3,arg4,arg5,arg6);
   _swig_go_result = result; 
   {
-    if (arg8 && *arg8) {
-      _swig_go_7->n = strlen(*arg8);
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
     }
   }
-  free(arg2); 
-  free(arg3); 
-  free(arg6); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, bool _swig_go_4, _gostring_ _swig_go_5, intgo _swig_go_6, bool _swig_go_7, bool _swig_go_8, _gostring_* _swig_go_9) {
+intgo _wrap_CfdGetConfidentialTxOutByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3, int64_t *_swig_go_4, _gostring_* _swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7, _gostring_* _swig_go_8, _gostring_* _swig_go_9) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  char *arg3 = (char *) 0 ;
-  uint32_t arg4 ;
-  bool arg5 ;
-  char *arg6 = (char *) 0 ;
-  int arg7 ;
-  bool arg8 ;
-  bool arg9 ;
+  void *arg2 = (void *) 0 ;
+  uint32_t arg3 ;
+  char **arg4 = (char **) 0 ;
+  int64_t *arg5 = (int64_t *) 0 ;
+  char **arg6 = (char **) 0 ;
+  char **arg7 = (char **) 0 ;
+  char **arg8 = (char **) 0 ;
+  char **arg9 = (char **) 0 ;
   char **arg10 = (char **) 0 ;
-  uint32_t *argp4 ;
+  uint32_t *argp3 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
-  
-  
-  argp4 = (uint32_t *)_swig_go_3;
-  if (argp4 == NULL) {
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
     _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  arg4 = (uint32_t)*argp4;
-  
-  arg5 = (bool)_swig_go_4; 
-  
-  arg6 = (char *)malloc(_swig_go_5.n + 1);
-  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
-  arg6[_swig_go_5.n] = '\0';
+  arg3 = (uint32_t)*argp3;
   
-  arg7 = (int)_swig_go_6; 
-  arg8 = (bool)_swig_go_7; 
-  arg9 = (bool)_swig_go_8; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(int64_t **)&_swig_go_4; 
+  arg6 = *(char ***)&_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  arg9 = *(char ***)&_swig_go_8; 
   arg10 = *(char ***)&_swig_go_9; 
   
-  result = (int)CfdAddConfidentialTxDerSign(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,arg7,arg8,arg9,arg10);
+  result = (int)CfdGetConfidentialTxOutByHandle(arg1,arg2,arg3,arg4,arg5,arg6,arg7,arg8,arg9,arg10);
   _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  {
+    if (arg9 && *arg9) {
+      _swig_go_8->n = strlen(*arg9);
+    }
+  }
   {
     if (arg10 && *arg10) {
       _swig_go_9->n = strlen(*arg10);
     }
   }
-  free(arg2); 
-  free(arg3); 
-  free(arg6); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, uint32_t *_swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, bool _swig_go_8, _gostring_* _swig_go_9) {
+intgo _wrap_CfdGetTxInCountByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2) {
   void *arg1 = (void *) 0 ;
   void *arg2 = (void *) 0 ;
-  char *arg3 = (char *) 0 ;
-  char *arg4 = (char *) 0 ;
-  uint32_t arg5 ;
-  int arg6 ;
-  char *arg7 = (char *) 0 ;
-  char *arg8 = (char *) 0 ;
-  bool arg9 ;
-  char **arg10 = (char **) 0 ;
-  uint32_t *argp5 ;
+  uint32_t *arg3 = (uint32_t *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
   arg2 = *(void **)&_swig_go_1; 
+  arg3 = *(uint32_t **)&_swig_go_2; 
   
-  arg3 = (char *)malloc(_swig_go_2.n + 1);
-  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
-  arg3[_swig_go_2.n] = '\0';
-  
-  
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
+  result = (int)CfdGetTxInCountByHandle(arg1,arg2,arg3);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetTxInWitnessCountByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, intgo _swig_go_2, uint32_t *_swig_go_3, uint32_t *_swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int arg3 ;
+  uint32_t arg4 ;
+  uint32_t *arg5 = (uint32_t *) 0 ;
+  uint32_t *argp4 ;
+  int result;
+  intgo _swig_go_result;
   
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
   
-  argp5 = (uint32_t *)_swig_go_4;
-  if (argp5 == NULL) {
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
     _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  arg5 = (uint32_t)*argp5;
-  
-  arg6 = (int)_swig_go_5; 
-  
-  arg7 = (char *)malloc(_swig_go_6.n + 1);
-  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
-  arg7[_swig_go_6.n] = '\0';
+  arg4 = (uint32_t)*argp4;
   
+  arg5 = *(uint32_t **)&_swig_go_4; 
   
-  arg8 = (char *)malloc(_swig_go_7.n + 1);
-  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
-  arg8[_swig_go_7.n] = '\0';
+  result = (int)CfdGetTxInWitnessCountByHandle(arg1,arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetTxOutCountByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  uint32_t *arg3 = (uint32_t *) 0 ;
+  int result;
+  intgo _swig_go_result;
   
-  arg9 = (bool)_swig_go_8; 
-  arg10 = *(char ***)&_swig_go_9; 
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  arg3 = *(uint32_t **)&_swig_go_2; 
   
-  result = (int)CfdFinalizeElementsMultisigSign(arg1,arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,(char const *)arg7,(char const *)arg8,arg9,arg10);
+  result = (int)CfdGetTxOutCountByHandle(arg1,arg2,arg3);
   _swig_go_result = result; 
-  {
-    if (arg10 && *arg10) {
-      _swig_go_9->n = strlen(*arg10);
-    }
-  }
-  free(arg3); 
-  free(arg4); 
-  free(arg7); 
-  free(arg8); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, intgo _swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, int64_t *_swig_go_7, _gostring_ _swig_go_8, intgo _swig_go_9, bool _swig_go_10, _gostring_* _swig_go_11) {
+intgo _wrap_CfdGetTxInIndexByHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, uint32_t *_swig_go_4) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
+  void *arg2 = (void *) 0 ;
   char *arg3 = (char *) 0 ;
   uint32_t arg4 ;
-  int arg5 ;
-  char *arg6 = (char *) 0 ;
-  char *arg7 = (char *) 0 ;
-  int64_t arg8 ;
-  char *arg9 = (char *) 0 ;
-  int arg10 ;
-  bool arg11 ;
-  char **arg12 = (char **) 0 ;
+  uint32_t *arg5 = (uint32_t *) 0 ;
   uint32_t *argp4 ;
-  int64_t *argp8 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
+  arg2 = *(void **)&_swig_go_1; 
   
   arg3 = (char *)malloc(_swig_go_2.n + 1);
   memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
@@ -2477,59 +2633,29 @@ intgo _wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(void *_swig_go_0
   }
   arg4 = (uint32_t)*argp4;
   
-  arg5 = (int)_swig_go_4; 
+  arg5 = *(uint32_t **)&_swig_go_4; 
   
-  arg6 = (char *)malloc(_swig_go_5.n + 1);
-  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
-  arg6[_swig_go_5.n] = '\0';
-  
-  
-  arg7 = (char *)malloc(_swig_go_6.n + 1);
-  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
-  arg7[_swig_go_6.n] = '\0';
-  
-  
-  argp8 = (int64_t *)_swig_go_7;
-  if (argp8 == NULL) {
-    _swig_gopanic("Attempt to dereference null int64_t");
-  }
-  arg8 = (int64_t)*argp8;
-  
-  
-  arg9 = (char *)malloc(_swig_go_8.n + 1);
-  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
-  arg9[_swig_go_8.n] = '\0';
-  
-  arg10 = (int)_swig_go_9; 
-  arg11 = (bool)_swig_go_10; 
-  arg12 = *(char ***)&_swig_go_11; 
-  
-  result = (int)CfdCreateConfidentialSighash(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,(char const *)arg7,arg8,(char const *)arg9,arg10,arg11,arg12);
+  result = (int)CfdGetTxInIndexByHandle(arg1,arg2,(char const *)arg3,arg4,arg5);
   _swig_go_result = result; 
-  {
-    if (arg12 && *arg12) {
-      _swig_go_11->n = strlen(*arg12);
-    }
-  }
-  free(arg2); 
   free(arg3); 
-  free(arg6); 
-  free(arg7); 
-  free(arg9); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3, _gostring_* _swig_go_4, int64_t *_swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7) {
+intgo _wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, int64_t *_swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, _gostring_ _swig_go_8, _gostring_* _swig_go_9, _gostring_* _swig_go_10) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
-  uint32_t arg3 ;
-  char *arg4 = (char *) 0 ;
-  char **arg5 = (char **) 0 ;
-  int64_t *arg6 = (int64_t *) 0 ;
-  char **arg7 = (char **) 0 ;
-  char **arg8 = (char **) 0 ;
-  uint32_t *argp3 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  int64_t arg5 ;
+  char *arg6 = (char *) 0 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  char *arg9 = (char *) 0 ;
+  char **arg10 = (char **) 0 ;
+  char **arg11 = (char **) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp5 ;
   int result;
   intgo _swig_go_result;
   
@@ -2540,60 +2666,89 @@ intgo _wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_
   arg2[_swig_go_1.n] = '\0';
   
   
-  argp3 = (uint32_t *)_swig_go_2;
-  if (argp3 == NULL) {
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
     _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  arg3 = (uint32_t)*argp3;
+  arg4 = (uint32_t)*argp4;
   
   
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
+  argp5 = (int64_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg5 = (int64_t)*argp5;
   
-  arg5 = *(char ***)&_swig_go_4; 
-  arg6 = *(int64_t **)&_swig_go_5; 
-  arg7 = *(char ***)&_swig_go_6; 
-  arg8 = *(char ***)&_swig_go_7; 
   
-  result = (int)CfdUnblindTxOut(arg1,(char const *)arg2,arg3,(char const *)arg4,arg5,arg6,arg7,arg8);
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  arg10 = *(char ***)&_swig_go_9; 
+  arg11 = *(char ***)&_swig_go_10; 
+  
+  result = (int)CfdSetRawReissueAsset(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,(char const *)arg7,(char const *)arg8,(char const *)arg9,arg10,arg11);
   _swig_go_result = result; 
   {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
-    }
-  }
-  {
-    if (arg7 && *arg7) {
-      _swig_go_6->n = strlen(*arg7);
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
     }
   }
   {
-    if (arg8 && *arg8) {
-      _swig_go_7->n = strlen(*arg8);
+    if (arg11 && *arg11) {
+      _swig_go_10->n = strlen(*arg11);
     }
   }
   free(arg2); 
-  free(arg4); 
+  free(arg3); 
+  free(arg6); 
+  free(arg7); 
+  free(arg8); 
+  free(arg9); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, _gostring_* _swig_go_5, int64_t *_swig_go_6, _gostring_* _swig_go_7, _gostring_* _swig_go_8, _gostring_* _swig_go_9, int64_t *_swig_go_10, _gostring_* _swig_go_11, _gostring_* _swig_go_12) {
+intgo _wrap_CfdSetRawIssueAsset_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_ _swig_go_4, int64_t *_swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, int64_t *_swig_go_8, _gostring_ _swig_go_9, _gostring_ _swig_go_10, bool _swig_go_11, _gostring_* _swig_go_12, _gostring_* _swig_go_13, _gostring_* _swig_go_14, _gostring_* _swig_go_15) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
-  uint32_t arg3 ;
-  char *arg4 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
   char *arg5 = (char *) 0 ;
-  char **arg6 = (char **) 0 ;
-  int64_t *arg7 = (int64_t *) 0 ;
-  char **arg8 = (char **) 0 ;
-  char **arg9 = (char **) 0 ;
-  char **arg10 = (char **) 0 ;
-  int64_t *arg11 = (int64_t *) 0 ;
-  char **arg12 = (char **) 0 ;
+  int64_t arg6 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  int64_t arg9 ;
+  char *arg10 = (char *) 0 ;
+  char *arg11 = (char *) 0 ;
+  bool arg12 ;
   char **arg13 = (char **) 0 ;
-  uint32_t *argp3 ;
+  char **arg14 = (char **) 0 ;
+  char **arg15 = (char **) 0 ;
+  char **arg16 = (char **) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp6 ;
+  int64_t *argp9 ;
   int result;
   intgo _swig_go_result;
   
@@ -2604,102 +2759,102 @@ intgo _wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostrin
   arg2[_swig_go_1.n] = '\0';
   
   
-  argp3 = (uint32_t *)_swig_go_2;
-  if (argp3 == NULL) {
-    _swig_gopanic("Attempt to dereference null uint32_t");
-  }
-  arg3 = (uint32_t)*argp3;
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
   
   
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
   
   
   arg5 = (char *)malloc(_swig_go_4.n + 1);
   memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
   arg5[_swig_go_4.n] = '\0';
   
-  arg6 = *(char ***)&_swig_go_5; 
-  arg7 = *(int64_t **)&_swig_go_6; 
-  arg8 = *(char ***)&_swig_go_7; 
-  arg9 = *(char ***)&_swig_go_8; 
-  arg10 = *(char ***)&_swig_go_9; 
-  arg11 = *(int64_t **)&_swig_go_10; 
-  arg12 = *(char ***)&_swig_go_11; 
-  arg13 = *(char ***)&_swig_go_12; 
   
-  result = (int)CfdUnblindIssuance(arg1,(char const *)arg2,arg3,(char const *)arg4,(char const *)arg5,arg6,arg7,arg8,arg9,arg10,arg11,arg12,arg13);
-  _swig_go_result = result; 
-  {
-    if (arg6 && *arg6) {
-      _swig_go_5->n = strlen(*arg6);
-    }
+  argp6 = (int64_t *)_swig_go_5;
+  if (argp6 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
   }
-  {
-    if (arg8 && *arg8) {
-      _swig_go_7->n = strlen(*arg8);
-    }
+  arg6 = (int64_t)*argp6;
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  argp9 = (int64_t *)_swig_go_8;
+  if (argp9 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
   }
+  arg9 = (int64_t)*argp9;
+  
+  
+  arg10 = (char *)malloc(_swig_go_9.n + 1);
+  memcpy(arg10, _swig_go_9.p, _swig_go_9.n);
+  arg10[_swig_go_9.n] = '\0';
+  
+  
+  arg11 = (char *)malloc(_swig_go_10.n + 1);
+  memcpy(arg11, _swig_go_10.p, _swig_go_10.n);
+  arg11[_swig_go_10.n] = '\0';
+  
+  arg12 = (bool)_swig_go_11; 
+  arg13 = *(char ***)&_swig_go_12; 
+  arg14 = *(char ***)&_swig_go_13; 
+  arg15 = *(char ***)&_swig_go_14; 
+  arg16 = *(char ***)&_swig_go_15; 
+  
+  result = (int)CfdSetRawIssueAsset(arg1,(char const *)arg2,(char const *)arg3,arg4,(char const *)arg5,arg6,(char const *)arg7,(char const *)arg8,arg9,(char const *)arg10,(char const *)arg11,arg12,arg13,arg14,arg15,arg16);
+  _swig_go_result = result; 
   {
-    if (arg9 && *arg9) {
-      _swig_go_8->n = strlen(*arg9);
+    if (arg13 && *arg13) {
+      _swig_go_12->n = strlen(*arg13);
     }
   }
   {
-    if (arg10 && *arg10) {
-      _swig_go_9->n = strlen(*arg10);
+    if (arg14 && *arg14) {
+      _swig_go_13->n = strlen(*arg14);
     }
   }
   {
-    if (arg12 && *arg12) {
-      _swig_go_11->n = strlen(*arg12);
+    if (arg15 && *arg15) {
+      _swig_go_14->n = strlen(*arg15);
     }
   }
   {
-    if (arg13 && *arg13) {
-      _swig_go_12->n = strlen(*arg13);
+    if (arg16 && *arg16) {
+      _swig_go_15->n = strlen(*arg16);
     }
   }
   free(arg2); 
-  free(arg4); 
+  free(arg3); 
   free(arg5); 
+  free(arg7); 
+  free(arg8); 
+  free(arg10); 
+  free(arg11); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_kCfdExtPrivkey_cfdgo_a23e02774b82509b() {
-  enum CfdExtKeyType result;
-  intgo _swig_go_result;
-  
-  
-  result = kCfdExtPrivkey;
-  
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_kCfdExtPubkey_cfdgo_a23e02774b82509b() {
-  enum CfdExtKeyType result;
-  intgo _swig_go_result;
-  
-  
-  result = kCfdExtPubkey;
-  
-  _swig_go_result = (intgo)result; 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, intgo _swig_go_4, bool _swig_go_5, _gostring_* _swig_go_6) {
+intgo _wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_* _swig_go_4) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
   char *arg3 = (char *) 0 ;
-  char *arg4 = (char *) 0 ;
-  int arg5 ;
-  bool arg6 ;
-  char **arg7 = (char **) 0 ;
+  uint32_t arg4 ;
+  char **arg5 = (char **) 0 ;
+  uint32_t *argp4 ;
   int result;
   intgo _swig_go_result;
   
@@ -2715,169 +2870,202 @@ intgo _wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _go
   arg3[_swig_go_2.n] = '\0';
   
   
-  arg4 = (char *)malloc(_swig_go_3.n + 1);
-  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
-  arg4[_swig_go_3.n] = '\0';
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
   
-  arg5 = (int)_swig_go_4; 
-  arg6 = (bool)_swig_go_5; 
-  arg7 = *(char ***)&_swig_go_6; 
+  arg5 = *(char ***)&_swig_go_4; 
   
-  result = (int)CfdCalculateEcSignature(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,arg7);
+  result = (int)CfdGetIssuanceBlindingKey(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5);
   _swig_go_result = result; 
   {
-    if (arg7 && *arg7) {
-      _swig_go_6->n = strlen(*arg7);
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
     }
   }
   free(arg2); 
   free(arg3); 
-  free(arg4); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdCreateKeyPair_cfdgo_a23e02774b82509b(void *_swig_go_0, bool _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, _gostring_* _swig_go_5) {
+intgo _wrap_CfdInitializeBlindTx_cfdgo_a23e02774b82509b(void *_swig_go_0, void **_swig_go_1) {
   void *arg1 = (void *) 0 ;
-  bool arg2 ;
-  int arg3 ;
-  char **arg4 = (char **) 0 ;
-  char **arg5 = (char **) 0 ;
-  char **arg6 = (char **) 0 ;
+  void **arg2 = (void **) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  arg2 = (bool)_swig_go_1; 
-  arg3 = (int)_swig_go_2; 
-  arg4 = *(char ***)&_swig_go_3; 
-  arg5 = *(char ***)&_swig_go_4; 
-  arg6 = *(char ***)&_swig_go_5; 
+  arg2 = *(void ***)&_swig_go_1; 
   
-  result = (int)CfdCreateKeyPair(arg1,arg2,arg3,arg4,arg5,arg6);
+  result = (int)CfdInitializeBlindTx(arg1,arg2);
   _swig_go_result = result; 
-  {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
-    }
-  }
-  {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
-    }
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, int64_t *_swig_go_7, _gostring_ _swig_go_8, _gostring_ _swig_go_9) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  char *arg5 = (char *) 0 ;
+  char *arg6 = (char *) 0 ;
+  char *arg7 = (char *) 0 ;
+  int64_t arg8 ;
+  char *arg9 = (char *) 0 ;
+  char *arg10 = (char *) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp8 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  {
-    if (arg6 && *arg6) {
-      _swig_go_5->n = strlen(*arg6);
-    }
+  arg4 = (uint32_t)*argp4;
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  argp8 = (int64_t *)_swig_go_7;
+  if (argp8 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
   }
+  arg8 = (int64_t)*argp8;
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  
+  arg10 = (char *)malloc(_swig_go_9.n + 1);
+  memcpy(arg10, _swig_go_9.p, _swig_go_9.n);
+  arg10[_swig_go_9.n] = '\0';
+  
+  
+  result = (int)CfdAddBlindTxInData(arg1,arg2,(char const *)arg3,arg4,(char const *)arg5,(char const *)arg6,(char const *)arg7,arg8,(char const *)arg9,(char const *)arg10);
+  _swig_go_result = result; 
+  free(arg3); 
+  free(arg5); 
+  free(arg6); 
+  free(arg7); 
+  free(arg9); 
+  free(arg10); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+intgo _wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  char **arg4 = (char **) 0 ;
+  void *arg2 = (void *) 0 ;
+  uint32_t arg3 ;
+  char *arg4 = (char *) 0 ;
+  uint32_t *argp3 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
   
-  arg3 = (int)_swig_go_2; 
-  arg4 = *(char ***)&_swig_go_3; 
   
-  result = (int)CfdGetPrivkeyFromWif(arg1,(char const *)arg2,arg3,arg4);
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  result = (int)CfdAddBlindTxOutData(arg1,arg2,arg3,(char const *)arg4);
   _swig_go_result = result; 
-  {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
-    }
-  }
-  free(arg2); 
+  free(arg4); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, bool _swig_go_3, _gostring_* _swig_go_4) {
+intgo _wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
+  void *arg2 = (void *) 0 ;
   char *arg3 = (char *) 0 ;
-  bool arg4 ;
-  char **arg5 = (char **) 0 ;
+  char **arg4 = (char **) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
+  arg2 = *(void **)&_swig_go_1; 
   
   arg3 = (char *)malloc(_swig_go_2.n + 1);
   memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
   arg3[_swig_go_2.n] = '\0';
   
-  arg4 = (bool)_swig_go_3; 
-  arg5 = *(char ***)&_swig_go_4; 
+  arg4 = *(char ***)&_swig_go_3; 
   
-  result = (int)CfdGetPubkeyFromPrivkey(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5);
+  result = (int)CfdFinalizeBlindTx(arg1,arg2,(char const *)arg3,arg4);
   _swig_go_result = result; 
   {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
     }
   }
-  free(arg2); 
   free(arg3); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, intgo _swig_go_3, _gostring_* _swig_go_4) {
+intgo _wrap_CfdFreeBlindHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  int arg4 ;
-  char **arg5 = (char **) 0 ;
+  void *arg2 = (void *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = (int)_swig_go_2; 
-  arg4 = (int)_swig_go_3; 
-  arg5 = *(char ***)&_swig_go_4; 
-  
-  result = (int)CfdCreateExtkeyFromSeed(arg1,(char const *)arg2,arg3,arg4,arg5);
+  result = (int)CfdFreeBlindHandle(arg1,arg2);
   _swig_go_result = result; 
-  {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
-    }
-  }
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, intgo _swig_go_3, intgo _swig_go_4, _gostring_* _swig_go_5) {
+intgo _wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, bool _swig_go_4, _gostring_ _swig_go_5, bool _swig_go_6, _gostring_* _swig_go_7) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
   char *arg3 = (char *) 0 ;
-  int arg4 ;
-  int arg5 ;
-  char **arg6 = (char **) 0 ;
+  uint32_t arg4 ;
+  bool arg5 ;
+  char *arg6 = (char *) 0 ;
+  bool arg7 ;
+  char **arg8 = (char **) 0 ;
+  uint32_t *argp4 ;
   int result;
   intgo _swig_go_result;
   
@@ -2892,183 +3080,2120 @@ intgo _wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(void *_swig_go_
   memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
   arg3[_swig_go_2.n] = '\0';
   
-  arg4 = (int)_swig_go_3; 
-  arg5 = (int)_swig_go_4; 
-  arg6 = *(char ***)&_swig_go_5; 
   
-  result = (int)CfdCreateExtkeyFromParentPath(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,arg6);
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  arg5 = (bool)_swig_go_4; 
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  arg7 = (bool)_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  
+  result = (int)CfdAddConfidentialTxSign(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,arg7,arg8);
   _swig_go_result = result; 
   {
-    if (arg6 && *arg6) {
-      _swig_go_5->n = strlen(*arg6);
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
     }
   }
   free(arg2); 
   free(arg3); 
+  free(arg6); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+intgo _wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, bool _swig_go_4, _gostring_ _swig_go_5, intgo _swig_go_6, bool _swig_go_7, bool _swig_go_8, _gostring_* _swig_go_9) {
   void *arg1 = (void *) 0 ;
   char *arg2 = (char *) 0 ;
-  int arg3 ;
-  char **arg4 = (char **) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  bool arg5 ;
+  char *arg6 = (char *) 0 ;
+  int arg7 ;
+  bool arg8 ;
+  bool arg9 ;
+  char **arg10 = (char **) 0 ;
+  uint32_t *argp4 ;
   int result;
   intgo _swig_go_result;
   
-  arg1 = *(void **)&_swig_go_0; 
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  arg5 = (bool)_swig_go_4; 
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  arg7 = (int)_swig_go_6; 
+  arg8 = (bool)_swig_go_7; 
+  arg9 = (bool)_swig_go_8; 
+  arg10 = *(char ***)&_swig_go_9; 
+  
+  result = (int)CfdAddConfidentialTxDerSign(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,arg7,arg8,arg9,arg10);
+  _swig_go_result = result; 
+  {
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  free(arg6); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, uint32_t *_swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, bool _swig_go_8, _gostring_* _swig_go_9) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  uint32_t arg5 ;
+  int arg6 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  bool arg9 ;
+  char **arg10 = (char **) 0 ;
+  uint32_t *argp5 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg5 = (uint32_t)*argp5;
+  
+  arg6 = (int)_swig_go_5; 
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  arg9 = (bool)_swig_go_8; 
+  arg10 = *(char ***)&_swig_go_9; 
+  
+  result = (int)CfdFinalizeElementsMultisigSign(arg1,arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,(char const *)arg7,(char const *)arg8,arg9,arg10);
+  _swig_go_result = result; 
+  {
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
+    }
+  }
+  free(arg3); 
+  free(arg4); 
+  free(arg7); 
+  free(arg8); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, intgo _swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, int64_t *_swig_go_7, _gostring_ _swig_go_8, intgo _swig_go_9, bool _swig_go_10, _gostring_* _swig_go_11) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  int arg5 ;
+  char *arg6 = (char *) 0 ;
+  char *arg7 = (char *) 0 ;
+  int64_t arg8 ;
+  char *arg9 = (char *) 0 ;
+  int arg10 ;
+  bool arg11 ;
+  char **arg12 = (char **) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp8 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  arg5 = (int)_swig_go_4; 
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  argp8 = (int64_t *)_swig_go_7;
+  if (argp8 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg8 = (int64_t)*argp8;
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  arg10 = (int)_swig_go_9; 
+  arg11 = (bool)_swig_go_10; 
+  arg12 = *(char ***)&_swig_go_11; 
+  
+  result = (int)CfdCreateConfidentialSighash(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,(char const *)arg7,arg8,(char const *)arg9,arg10,arg11,arg12);
+  _swig_go_result = result; 
+  {
+    if (arg12 && *arg12) {
+      _swig_go_11->n = strlen(*arg12);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  free(arg6); 
+  free(arg7); 
+  free(arg9); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3, _gostring_* _swig_go_4, int64_t *_swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  uint32_t arg3 ;
+  char *arg4 = (char *) 0 ;
+  char **arg5 = (char **) 0 ;
+  int64_t *arg6 = (int64_t *) 0 ;
+  char **arg7 = (char **) 0 ;
+  char **arg8 = (char **) 0 ;
+  uint32_t *argp3 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(int64_t **)&_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  
+  result = (int)CfdUnblindTxOut(arg1,(char const *)arg2,arg3,(char const *)arg4,arg5,arg6,arg7,arg8);
+  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  free(arg2); 
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, uint32_t *_swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, _gostring_* _swig_go_5, int64_t *_swig_go_6, _gostring_* _swig_go_7, _gostring_* _swig_go_8, _gostring_* _swig_go_9, int64_t *_swig_go_10, _gostring_* _swig_go_11, _gostring_* _swig_go_12) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  uint32_t arg3 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  char **arg6 = (char **) 0 ;
+  int64_t *arg7 = (int64_t *) 0 ;
+  char **arg8 = (char **) 0 ;
+  char **arg9 = (char **) 0 ;
+  char **arg10 = (char **) 0 ;
+  int64_t *arg11 = (int64_t *) 0 ;
+  char **arg12 = (char **) 0 ;
+  char **arg13 = (char **) 0 ;
+  uint32_t *argp3 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  arg6 = *(char ***)&_swig_go_5; 
+  arg7 = *(int64_t **)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  arg9 = *(char ***)&_swig_go_8; 
+  arg10 = *(char ***)&_swig_go_9; 
+  arg11 = *(int64_t **)&_swig_go_10; 
+  arg12 = *(char ***)&_swig_go_11; 
+  arg13 = *(char ***)&_swig_go_12; 
+  
+  result = (int)CfdUnblindIssuance(arg1,(char const *)arg2,arg3,(char const *)arg4,(char const *)arg5,arg6,arg7,arg8,arg9,arg10,arg11,arg12,arg13);
+  _swig_go_result = result; 
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  {
+    if (arg9 && *arg9) {
+      _swig_go_8->n = strlen(*arg9);
+    }
+  }
+  {
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
+    }
+  }
+  {
+    if (arg12 && *arg12) {
+      _swig_go_11->n = strlen(*arg12);
+    }
+  }
+  {
+    if (arg13 && *arg13) {
+      _swig_go_12->n = strlen(*arg13);
+    }
+  }
+  free(arg2); 
+  free(arg4); 
+  free(arg5); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdVerifyConfidentialTxSign_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, _gostring_ _swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, int64_t *_swig_go_7, _gostring_ _swig_go_8) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  char *arg5 = (char *) 0 ;
+  int arg6 ;
+  char *arg7 = (char *) 0 ;
+  int64_t arg8 ;
+  char *arg9 = (char *) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp8 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  arg6 = (int)_swig_go_5; 
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  argp8 = (int64_t *)_swig_go_7;
+  if (argp8 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg8 = (int64_t)*argp8;
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  
+  result = (int)CfdVerifyConfidentialTxSign(arg1,(char const *)arg2,(char const *)arg3,arg4,(char const *)arg5,arg6,(char const *)arg7,arg8,(char const *)arg9);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  free(arg5); 
+  free(arg7); 
+  free(arg9); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdVerifyConfidentialTxSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5, uint32_t *_swig_go_6, intgo _swig_go_7, bool _swig_go_8, int64_t *_swig_go_9, _gostring_ _swig_go_10, intgo _swig_go_11) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  char *arg6 = (char *) 0 ;
+  uint32_t arg7 ;
+  int arg8 ;
+  bool arg9 ;
+  int64_t arg10 ;
+  char *arg11 = (char *) 0 ;
+  int arg12 ;
+  uint32_t *argp7 ;
+  int64_t *argp10 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  argp7 = (uint32_t *)_swig_go_6;
+  if (argp7 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg7 = (uint32_t)*argp7;
+  
+  arg8 = (int)_swig_go_7; 
+  arg9 = (bool)_swig_go_8; 
+  
+  argp10 = (int64_t *)_swig_go_9;
+  if (argp10 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg10 = (int64_t)*argp10;
+  
+  
+  arg11 = (char *)malloc(_swig_go_10.n + 1);
+  memcpy(arg11, _swig_go_10.p, _swig_go_10.n);
+  arg11[_swig_go_10.n] = '\0';
+  
+  arg12 = (int)_swig_go_11; 
+  
+  result = (int)CfdVerifyConfidentialTxSignature(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4,(char const *)arg5,(char const *)arg6,arg7,arg8,arg9,arg10,(char const *)arg11,arg12);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
+  free(arg5); 
+  free(arg6); 
+  free(arg11); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_kCfdExtPrivkey_cfdgo_a23e02774b82509b() {
+  enum CfdExtKeyType result;
+  intgo _swig_go_result;
+  
+  
+  result = kCfdExtPrivkey;
+  
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_kCfdExtPubkey_cfdgo_a23e02774b82509b() {
+  enum CfdExtKeyType result;
+  intgo _swig_go_result;
+  
+  
+  result = kCfdExtPubkey;
+  
+  _swig_go_result = (intgo)result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, intgo _swig_go_4, bool _swig_go_5, _gostring_* _swig_go_6) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  int arg5 ;
+  bool arg6 ;
+  char **arg7 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  arg5 = (int)_swig_go_4; 
+  arg6 = (bool)_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  
+  result = (int)CfdCalculateEcSignature(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,arg7);
+  _swig_go_result = result; 
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdVerifyEcSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  result = (int)CfdVerifyEcSignature(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdEncodeSignatureByDer_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, bool _swig_go_3, _gostring_* _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  bool arg4 ;
+  char **arg5 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = (bool)_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  
+  result = (int)CfdEncodeSignatureByDer(arg1,(char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdDecodeSignatureFromDer_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, intgo *_swig_go_3, bool *_swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  int *arg4 = (int *) 0 ;
+  bool *arg5 = (bool *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(int **)&_swig_go_3; 
+  arg5 = *(bool **)&_swig_go_4; 
+  
+  result = (int)CfdDecodeSignatureFromDer(arg1,(char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdNormalizeSignature_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  
+  result = (int)CfdNormalizeSignature(arg1,(char const *)arg2,arg3);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdSignSchnorr_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, _gostring_* _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  char **arg5 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  arg5 = *(char ***)&_swig_go_4; 
+  
+  result = (int)CfdSignSchnorr(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdVerifySchnorr_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  result = (int)CfdVerifySchnorr(arg1,(char const *)arg2,(char const *)arg3,(char const *)arg4);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg3); 
+  free(arg4); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetSchnorrPubkeyFromPrivkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, bool *_swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  bool *arg4 = (bool *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(bool **)&_swig_go_3; 
+  
+  result = (int)CfdGetSchnorrPubkeyFromPrivkey(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetSchnorrPubkeyFromPubkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, bool *_swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  bool *arg4 = (bool *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(bool **)&_swig_go_3; 
+  
+  result = (int)CfdGetSchnorrPubkeyFromPubkey(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdSchnorrPubkeyTweakAdd_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3, bool *_swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  bool *arg5 = (bool *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(bool **)&_swig_go_4; 
+  
+  result = (int)CfdSchnorrPubkeyTweakAdd(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdSchnorrKeyPairTweakAdd_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3, bool *_swig_go_4, _gostring_* _swig_go_5) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  bool *arg5 = (bool *) 0 ;
+  char **arg6 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(bool **)&_swig_go_4; 
+  arg6 = *(char ***)&_swig_go_5; 
+  
+  result = (int)CfdSchnorrKeyPairTweakAdd(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,arg6);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCheckTweakAddFromSchnorrPubkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, bool _swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  bool arg3 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (bool)_swig_go_2; 
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  result = (int)CfdCheckTweakAddFromSchnorrPubkey(arg1,(char const *)arg2,arg3,(char const *)arg4,(char const *)arg5);
+  _swig_go_result = result; 
+  free(arg2); 
+  free(arg4); 
+  free(arg5); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdPubkeyTweakAdd_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdPubkeyTweakAdd(arg1,(char const *)arg2,(char const *)arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCompressPubkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  
+  result = (int)CfdCompressPubkey(arg1,(char const *)arg2,arg3);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateKeyPair_cfdgo_a23e02774b82509b(void *_swig_go_0, bool _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, _gostring_* _swig_go_5) {
+  void *arg1 = (void *) 0 ;
+  bool arg2 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  char **arg6 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (bool)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(char ***)&_swig_go_5; 
+  
+  result = (int)CfdCreateKeyPair(arg1,arg2,arg3,arg4,arg5,arg6);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetPrivkeyFromWif(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdParsePrivkeyWif_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, intgo *_swig_go_3, bool *_swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  int *arg4 = (int *) 0 ;
+  bool *arg5 = (bool *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(int **)&_swig_go_3; 
+  arg5 = *(bool **)&_swig_go_4; 
+  
+  result = (int)CfdParsePrivkeyWif(arg1,(char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, bool _swig_go_3, _gostring_* _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  bool arg4 ;
+  char **arg5 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = (bool)_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  
+  result = (int)CfdGetPubkeyFromPrivkey(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, intgo _swig_go_3, _gostring_* _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  int arg4 ;
+  char **arg5 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = (int)_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  
+  result = (int)CfdCreateExtkeyFromSeed(arg1,(char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, intgo _swig_go_3, intgo _swig_go_4, _gostring_* _swig_go_5) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  int arg4 ;
+  int arg5 ;
+  char **arg6 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = (int)_swig_go_3; 
+  arg5 = (int)_swig_go_4; 
+  arg6 = *(char ***)&_swig_go_5; 
+  
+  result = (int)CfdCreateExtkeyFromParentPath(arg1,(char const *)arg2,(char const *)arg3,arg4,arg5,arg6);
+  _swig_go_result = result; 
+  {
+    if (arg6 && *arg6) {
+      _swig_go_5->n = strlen(*arg6);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdCreateExtPubkey(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  
+  result = (int)CfdGetPrivkeyFromExtkey(arg1,(char const *)arg2,arg3,arg4,arg5);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  int arg3 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = (int)_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetPubkeyFromExtkey(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdCreateExtkey_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, intgo _swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, char _swig_go_7, uint32_t *_swig_go_8, _gostring_* _swig_go_9) {
+  void *arg1 = (void *) 0 ;
+  int arg2 ;
+  int arg3 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  char *arg6 = (char *) 0 ;
+  char *arg7 = (char *) 0 ;
+  unsigned char arg8 ;
+  uint32_t arg9 ;
+  char **arg10 = (char **) 0 ;
+  uint32_t *argp9 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  arg8 = (unsigned char)_swig_go_7; 
+  
+  argp9 = (uint32_t *)_swig_go_8;
+  if (argp9 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg9 = (uint32_t)*argp9;
+  
+  arg10 = *(char ***)&_swig_go_9; 
+  
+  result = (int)CfdCreateExtkey(arg1,arg2,arg3,(char const *)arg4,(char const *)arg5,(char const *)arg6,(char const *)arg7,arg8,arg9,arg10);
+  _swig_go_result = result; 
+  {
+    if (arg10 && *arg10) {
+      _swig_go_9->n = strlen(*arg10);
+    }
+  }
+  free(arg4); 
+  free(arg5); 
+  free(arg6); 
+  free(arg7); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetExtkeyInformation_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_* _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4, uint32_t *_swig_go_5, uint32_t *_swig_go_6) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char **arg3 = (char **) 0 ;
+  char **arg4 = (char **) 0 ;
+  char **arg5 = (char **) 0 ;
+  uint32_t *arg6 = (uint32_t *) 0 ;
+  uint32_t *arg7 = (uint32_t *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(char ***)&_swig_go_2; 
+  arg4 = *(char ***)&_swig_go_3; 
+  arg5 = *(char ***)&_swig_go_4; 
+  arg6 = *(uint32_t **)&_swig_go_5; 
+  arg7 = *(uint32_t **)&_swig_go_6; 
+  
+  result = (int)CfdGetExtkeyInformation(arg1,(char const *)arg2,arg3,arg4,arg5,arg6,arg7);
+  _swig_go_result = result; 
+  {
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
+    }
+  }
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  {
+    if (arg5 && *arg5) {
+      _swig_go_4->n = strlen(*arg5);
+    }
+  }
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdInitializeMnemonicWordList_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, void **_swig_go_2, uint32_t *_swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  void **arg3 = (void **) 0 ;
+  uint32_t *arg4 = (uint32_t *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(void ***)&_swig_go_2; 
+  arg4 = *(uint32_t **)&_swig_go_3; 
+  
+  result = (int)CfdInitializeMnemonicWordList(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetMnemonicWord_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  uint32_t arg3 ;
+  char **arg4 = (char **) 0 ;
+  uint32_t *argp3 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetMnemonicWord(arg1,arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdFreeMnemonicWordList_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  result = (int)CfdFreeMnemonicWordList(arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdConvertMnemonicToSeed_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, bool _swig_go_3, _gostring_ _swig_go_4, bool _swig_go_5, _gostring_* _swig_go_6, _gostring_* _swig_go_7) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  bool arg4 ;
+  char *arg5 = (char *) 0 ;
+  bool arg6 ;
+  char **arg7 = (char **) 0 ;
+  char **arg8 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = (bool)_swig_go_3; 
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  arg6 = (bool)_swig_go_5; 
+  arg7 = *(char ***)&_swig_go_6; 
+  arg8 = *(char ***)&_swig_go_7; 
+  
+  result = (int)CfdConvertMnemonicToSeed(arg1,(char const *)arg2,(char const *)arg3,arg4,(char const *)arg5,arg6,arg7,arg8);
+  _swig_go_result = result; 
+  {
+    if (arg7 && *arg7) {
+      _swig_go_6->n = strlen(*arg7);
+    }
+  }
+  {
+    if (arg8 && *arg8) {
+      _swig_go_7->n = strlen(*arg8);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  free(arg5); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdConvertEntropyToMnemonic_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, _gostring_ _swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  char *arg3 = (char *) 0 ;
+  char **arg4 = (char **) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdConvertEntropyToMnemonic(arg1,(char const *)arg2,(char const *)arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  free(arg2); 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdParseScript_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, void **_swig_go_2, uint32_t *_swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  char *arg2 = (char *) 0 ;
+  void **arg3 = (void **) 0 ;
+  uint32_t *arg4 = (uint32_t *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  
+  arg2 = (char *)malloc(_swig_go_1.n + 1);
+  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
+  arg2[_swig_go_1.n] = '\0';
+  
+  arg3 = *(void ***)&_swig_go_2; 
+  arg4 = *(uint32_t **)&_swig_go_3; 
+  
+  result = (int)CfdParseScript(arg1,(char const *)arg2,arg3,arg4);
+  _swig_go_result = result; 
+  free(arg2); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdGetScriptItem_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  uint32_t arg3 ;
+  char **arg4 = (char **) 0 ;
+  uint32_t *argp3 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
+  
+  arg4 = *(char ***)&_swig_go_3; 
+  
+  result = (int)CfdGetScriptItem(arg1,arg2,arg3,arg4);
+  _swig_go_result = result; 
+  {
+    if (arg4 && *arg4) {
+      _swig_go_3->n = strlen(*arg4);
+    }
+  }
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdFreeScriptItemHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  result = (int)CfdFreeScriptItemHandle(arg1,arg2);
+  _swig_go_result = result; 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdInitializeTransaction_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, uint32_t *_swig_go_2, uint32_t *_swig_go_3, _gostring_ _swig_go_4, void **_swig_go_5) {
+  void *arg1 = (void *) 0 ;
+  int arg2 ;
+  uint32_t arg3 ;
+  uint32_t arg4 ;
+  char *arg5 = (char *) 0 ;
+  void **arg6 = (void **) 0 ;
+  uint32_t *argp3 ;
+  uint32_t *argp4 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = (int)_swig_go_1; 
+  
+  argp3 = (uint32_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg3 = (uint32_t)*argp3;
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  arg6 = *(void ***)&_swig_go_5; 
+  
+  result = (int)CfdInitializeTransaction(arg1,arg2,arg3,arg4,(char const *)arg5,arg6);
+  _swig_go_result = result; 
+  free(arg5); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdAddTransactionInput_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, uint32_t *_swig_go_4) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  uint32_t arg5 ;
+  uint32_t *argp4 ;
+  uint32_t *argp5 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg5 = (uint32_t)*argp5;
+  
+  
+  result = (int)CfdAddTransactionInput(arg1,arg2,(char const *)arg3,arg4,arg5);
+  _swig_go_result = result; 
+  free(arg3); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdAddTransactionOutput_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, int64_t *_swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, _gostring_ _swig_go_5) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int64_t arg3 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  char *arg6 = (char *) 0 ;
+  int64_t *argp3 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  argp3 = (int64_t *)_swig_go_2;
+  if (argp3 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg3 = (int64_t)*argp3;
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  result = (int)CfdAddTransactionOutput(arg1,arg2,arg3,(char const *)arg4,(char const *)arg5,(char const *)arg6);
+  _swig_go_result = result; 
+  free(arg4); 
+  free(arg5); 
+  free(arg6); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdAddTxPeginInput_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, uint32_t *_swig_go_3, int64_t *_swig_go_4, _gostring_ _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, _gostring_ _swig_go_8, _gostring_ _swig_go_9) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  char *arg3 = (char *) 0 ;
+  uint32_t arg4 ;
+  int64_t arg5 ;
+  char *arg6 = (char *) 0 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  char *arg9 = (char *) 0 ;
+  char *arg10 = (char *) 0 ;
+  uint32_t *argp4 ;
+  int64_t *argp5 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (uint32_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg4 = (uint32_t)*argp4;
+  
+  
+  argp5 = (int64_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg5 = (int64_t)*argp5;
+  
+  
+  arg6 = (char *)malloc(_swig_go_5.n + 1);
+  memcpy(arg6, _swig_go_5.p, _swig_go_5.n);
+  arg6[_swig_go_5.n] = '\0';
+  
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  
+  arg10 = (char *)malloc(_swig_go_9.n + 1);
+  memcpy(arg10, _swig_go_9.p, _swig_go_9.n);
+  arg10[_swig_go_9.n] = '\0';
+  
+  
+  result = (int)CfdAddTxPeginInput(arg1,arg2,(char const *)arg3,arg4,arg5,(char const *)arg6,(char const *)arg7,(char const *)arg8,(char const *)arg9,(char const *)arg10);
+  _swig_go_result = result; 
+  free(arg3); 
+  free(arg6); 
+  free(arg7); 
+  free(arg8); 
+  free(arg9); 
+  free(arg10); 
+  return _swig_go_result;
+}
+
+
+intgo _wrap_CfdAddTxPegoutOutput_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_ _swig_go_2, int64_t *_swig_go_3, intgo _swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, _gostring_ _swig_go_8, _gostring_ _swig_go_9, uint32_t *_swig_go_10, _gostring_ _swig_go_11, _gostring_* _swig_go_12) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  char *arg3 = (char *) 0 ;
+  int64_t arg4 ;
+  int arg5 ;
+  int arg6 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  char *arg9 = (char *) 0 ;
+  char *arg10 = (char *) 0 ;
+  uint32_t arg11 ;
+  char *arg12 = (char *) 0 ;
+  char **arg13 = (char **) 0 ;
+  int64_t *argp4 ;
+  uint32_t *argp11 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  argp4 = (int64_t *)_swig_go_3;
+  if (argp4 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg4 = (int64_t)*argp4;
+  
+  arg5 = (int)_swig_go_4; 
+  arg6 = (int)_swig_go_5; 
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  arg10 = (char *)malloc(_swig_go_9.n + 1);
+  memcpy(arg10, _swig_go_9.p, _swig_go_9.n);
+  arg10[_swig_go_9.n] = '\0';
   
-  arg3 = (int)_swig_go_2; 
-  arg4 = *(char ***)&_swig_go_3; 
   
-  result = (int)CfdCreateExtPubkey(arg1,(char const *)arg2,arg3,arg4);
-  _swig_go_result = result; 
-  {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
-    }
+  argp11 = (uint32_t *)_swig_go_10;
+  if (argp11 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  free(arg2); 
-  return _swig_go_result;
-}
-
-
-intgo _wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3, _gostring_* _swig_go_4) {
-  void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  char **arg4 = (char **) 0 ;
-  char **arg5 = (char **) 0 ;
-  int result;
-  intgo _swig_go_result;
+  arg11 = (uint32_t)*argp11;
   
-  arg1 = *(void **)&_swig_go_0; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
+  arg12 = (char *)malloc(_swig_go_11.n + 1);
+  memcpy(arg12, _swig_go_11.p, _swig_go_11.n);
+  arg12[_swig_go_11.n] = '\0';
   
-  arg3 = (int)_swig_go_2; 
-  arg4 = *(char ***)&_swig_go_3; 
-  arg5 = *(char ***)&_swig_go_4; 
+  arg13 = *(char ***)&_swig_go_12; 
   
-  result = (int)CfdGetPrivkeyFromExtkey(arg1,(char const *)arg2,arg3,arg4,arg5);
+  result = (int)CfdAddTxPegoutOutput(arg1,arg2,(char const *)arg3,arg4,arg5,arg6,(char const *)arg7,(char const *)arg8,(char const *)arg9,(char const *)arg10,arg11,(char const *)arg12,arg13);
   _swig_go_result = result; 
   {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
-    }
-  }
-  {
-    if (arg5 && *arg5) {
-      _swig_go_4->n = strlen(*arg5);
+    if (arg13 && *arg13) {
+      _swig_go_12->n = strlen(*arg13);
     }
   }
-  free(arg2); 
+  free(arg3); 
+  free(arg7); 
+  free(arg8); 
+  free(arg9); 
+  free(arg10); 
+  free(arg12); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, intgo _swig_go_2, _gostring_* _swig_go_3) {
+intgo _wrap_CfdFinalizeTransaction_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, _gostring_* _swig_go_2) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  int arg3 ;
-  char **arg4 = (char **) 0 ;
+  void *arg2 = (void *) 0 ;
+  char **arg3 = (char **) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  arg3 = *(char ***)&_swig_go_2; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = (int)_swig_go_2; 
-  arg4 = *(char ***)&_swig_go_3; 
-  
-  result = (int)CfdGetPubkeyFromExtkey(arg1,(char const *)arg2,arg3,arg4);
+  result = (int)CfdFinalizeTransaction(arg1,arg2,arg3);
   _swig_go_result = result; 
   {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
+    if (arg3 && *arg3) {
+      _swig_go_2->n = strlen(*arg3);
     }
   }
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdParseScript_cfdgo_a23e02774b82509b(void *_swig_go_0, _gostring_ _swig_go_1, void **_swig_go_2, uint32_t *_swig_go_3) {
+intgo _wrap_CfdFreeTransactionHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
   void *arg1 = (void *) 0 ;
-  char *arg2 = (char *) 0 ;
-  void **arg3 = (void **) 0 ;
-  uint32_t *arg4 = (uint32_t *) 0 ;
+  void *arg2 = (void *) 0 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
   
-  arg2 = (char *)malloc(_swig_go_1.n + 1);
-  memcpy(arg2, _swig_go_1.p, _swig_go_1.n);
-  arg2[_swig_go_1.n] = '\0';
-  
-  arg3 = *(void ***)&_swig_go_2; 
-  arg4 = *(uint32_t **)&_swig_go_3; 
-  
-  result = (int)CfdParseScript(arg1,(char const *)arg2,arg3,arg4);
+  result = (int)CfdFreeTransactionHandle(arg1,arg2);
   _swig_go_result = result; 
-  free(arg2); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdGetScriptItem_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, uint32_t *_swig_go_2, _gostring_* _swig_go_3) {
+intgo _wrap_CfdCreateSighash_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, uint32_t *_swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, _gostring_ _swig_go_7, int64_t *_swig_go_8, intgo _swig_go_9, bool _swig_go_10, _gostring_* _swig_go_11) {
   void *arg1 = (void *) 0 ;
-  void *arg2 = (void *) 0 ;
-  uint32_t arg3 ;
-  char **arg4 = (char **) 0 ;
-  uint32_t *argp3 ;
+  int arg2 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  uint32_t arg5 ;
+  int arg6 ;
+  char *arg7 = (char *) 0 ;
+  char *arg8 = (char *) 0 ;
+  int64_t arg9 ;
+  int arg10 ;
+  bool arg11 ;
+  char **arg12 = (char **) 0 ;
+  uint32_t *argp5 ;
+  int64_t *argp9 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  arg2 = *(void **)&_swig_go_1; 
+  arg2 = (int)_swig_go_1; 
   
-  argp3 = (uint32_t *)_swig_go_2;
-  if (argp3 == NULL) {
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
     _swig_gopanic("Attempt to dereference null uint32_t");
   }
-  arg3 = (uint32_t)*argp3;
+  arg5 = (uint32_t)*argp5;
   
-  arg4 = *(char ***)&_swig_go_3; 
+  arg6 = (int)_swig_go_5; 
   
-  result = (int)CfdGetScriptItem(arg1,arg2,arg3,arg4);
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  argp9 = (int64_t *)_swig_go_8;
+  if (argp9 == NULL) {
+    _swig_gopanic("Attempt to dereference null int64_t");
+  }
+  arg9 = (int64_t)*argp9;
+  
+  arg10 = (int)_swig_go_9; 
+  arg11 = (bool)_swig_go_10; 
+  arg12 = *(char ***)&_swig_go_11; 
+  
+  result = (int)CfdCreateSighash(arg1,arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,(char const *)arg7,(char const *)arg8,arg9,arg10,arg11,arg12);
   _swig_go_result = result; 
   {
-    if (arg4 && *arg4) {
-      _swig_go_3->n = strlen(*arg4);
+    if (arg12 && *arg12) {
+      _swig_go_11->n = strlen(*arg12);
     }
   }
+  free(arg3); 
+  free(arg4); 
+  free(arg7); 
+  free(arg8); 
   return _swig_go_result;
 }
 
 
-intgo _wrap_CfdFreeScriptItemHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
+intgo _wrap_CfdAddTxSign_cfdgo_a23e02774b82509b(void *_swig_go_0, intgo _swig_go_1, _gostring_ _swig_go_2, _gostring_ _swig_go_3, uint32_t *_swig_go_4, intgo _swig_go_5, _gostring_ _swig_go_6, bool _swig_go_7, intgo _swig_go_8, bool _swig_go_9, bool _swig_go_10, _gostring_* _swig_go_11) {
   void *arg1 = (void *) 0 ;
-  void *arg2 = (void *) 0 ;
+  int arg2 ;
+  char *arg3 = (char *) 0 ;
+  char *arg4 = (char *) 0 ;
+  uint32_t arg5 ;
+  int arg6 ;
+  char *arg7 = (char *) 0 ;
+  bool arg8 ;
+  int arg9 ;
+  bool arg10 ;
+  bool arg11 ;
+  char **arg12 = (char **) 0 ;
+  uint32_t *argp5 ;
   int result;
   intgo _swig_go_result;
   
   arg1 = *(void **)&_swig_go_0; 
-  arg2 = *(void **)&_swig_go_1; 
+  arg2 = (int)_swig_go_1; 
   
-  result = (int)CfdFreeScriptItemHandle(arg1,arg2);
+  arg3 = (char *)malloc(_swig_go_2.n + 1);
+  memcpy(arg3, _swig_go_2.p, _swig_go_2.n);
+  arg3[_swig_go_2.n] = '\0';
+  
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  argp5 = (uint32_t *)_swig_go_4;
+  if (argp5 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg5 = (uint32_t)*argp5;
+  
+  arg6 = (int)_swig_go_5; 
+  
+  arg7 = (char *)malloc(_swig_go_6.n + 1);
+  memcpy(arg7, _swig_go_6.p, _swig_go_6.n);
+  arg7[_swig_go_6.n] = '\0';
+  
+  arg8 = (bool)_swig_go_7; 
+  arg9 = (int)_swig_go_8; 
+  arg10 = (bool)_swig_go_9; 
+  arg11 = (bool)_swig_go_10; 
+  arg12 = *(char ***)&_swig_go_11; 
+  
+  result = (int)CfdAddTxSign(arg1,arg2,(char const *)arg3,(char const *)arg4,arg5,arg6,(char const *)arg7,arg8,arg9,arg10,arg11,arg12);
   _swig_go_result = result; 
+  {
+    if (arg12 && *arg12) {
+      _swig_go_11->n = strlen(*arg12);
+    }
+  }
+  free(arg3); 
+  free(arg4); 
+  free(arg7); 
   return _swig_go_result;
 }
 
@@ -3150,6 +5275,71 @@ intgo _wrap_CfdAddMultisigSignDataToDer_cfdgo_a23e02774b82509b(void *_swig_go_0,
 }
 
 
+intgo _wrap_CfdFinalizeMultisigSign_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1, intgo _swig_go_2, _gostring_ _swig_go_3, _gostring_ _swig_go_4, uint32_t *_swig_go_5, intgo _swig_go_6, _gostring_ _swig_go_7, _gostring_ _swig_go_8, bool _swig_go_9, _gostring_* _swig_go_10) {
+  void *arg1 = (void *) 0 ;
+  void *arg2 = (void *) 0 ;
+  int arg3 ;
+  char *arg4 = (char *) 0 ;
+  char *arg5 = (char *) 0 ;
+  uint32_t arg6 ;
+  int arg7 ;
+  char *arg8 = (char *) 0 ;
+  char *arg9 = (char *) 0 ;
+  bool arg10 ;
+  char **arg11 = (char **) 0 ;
+  uint32_t *argp6 ;
+  int result;
+  intgo _swig_go_result;
+  
+  arg1 = *(void **)&_swig_go_0; 
+  arg2 = *(void **)&_swig_go_1; 
+  arg3 = (int)_swig_go_2; 
+  
+  arg4 = (char *)malloc(_swig_go_3.n + 1);
+  memcpy(arg4, _swig_go_3.p, _swig_go_3.n);
+  arg4[_swig_go_3.n] = '\0';
+  
+  
+  arg5 = (char *)malloc(_swig_go_4.n + 1);
+  memcpy(arg5, _swig_go_4.p, _swig_go_4.n);
+  arg5[_swig_go_4.n] = '\0';
+  
+  
+  argp6 = (uint32_t *)_swig_go_5;
+  if (argp6 == NULL) {
+    _swig_gopanic("Attempt to dereference null uint32_t");
+  }
+  arg6 = (uint32_t)*argp6;
+  
+  arg7 = (int)_swig_go_6; 
+  
+  arg8 = (char *)malloc(_swig_go_7.n + 1);
+  memcpy(arg8, _swig_go_7.p, _swig_go_7.n);
+  arg8[_swig_go_7.n] = '\0';
+  
+  
+  arg9 = (char *)malloc(_swig_go_8.n + 1);
+  memcpy(arg9, _swig_go_8.p, _swig_go_8.n);
+  arg9[_swig_go_8.n] = '\0';
+  
+  arg10 = (bool)_swig_go_9; 
+  arg11 = *(char ***)&_swig_go_10; 
+  
+  result = (int)CfdFinalizeMultisigSign(arg1,arg2,arg3,(char const *)arg4,(char const *)arg5,arg6,arg7,(char const *)arg8,(char const *)arg9,arg10,arg11);
+  _swig_go_result = result; 
+  {
+    if (arg11 && *arg11) {
+      _swig_go_10->n = strlen(*arg11);
+    }
+  }
+  free(arg4); 
+  free(arg5); 
+  free(arg8); 
+  free(arg9); 
+  return _swig_go_result;
+}
+
+
 intgo _wrap_CfdFreeMultisigSignHandle_cfdgo_a23e02774b82509b(void *_swig_go_0, void *_swig_go_1) {
   void *arg1 = (void *) 0 ;
   void *arg2 = (void *) 0 ;
@@ -115,6 +115,98 @@ typedef _gostring_ swig_type_80;
 typedef _gostring_ swig_type_81;
 typedef _gostring_ swig_type_82;
 typedef _gostring_ swig_type_83;
+typedef _gostring_ swig_type_84;
+typedef _gostring_ swig_type_85;
+typedef _gostring_ swig_type_86;
+typedef _gostring_ swig_type_87;
+typedef _gostring_ swig_type_88;
+typedef _gostring_ swig_type_89;
+typedef _gostring_ swig_type_90;
+typedef _gostring_ swig_type_91;
+typedef _gostring_ swig_type_92;
+typedef _gostring_ swig_type_93;
+typedef _gostring_ swig_type_94;
+typedef _gostring_ swig_type_95;
+typedef _gostring_ swig_type_96;
+typedef _gostring_ swig_type_97;
+typedef _gostring_ swig_type_98;
+typedef _gostring_ swig_type_99;
+typedef _gostring_ swig_type_100;
+typedef _gostring_ swig_type_101;
+typedef _gostring_ swig_type_102;
+typedef _gostring_ swig_type_103;
+typedef _gostring_ swig_type_104;
+typedef _gostring_ swig_type_105;
+typedef _gostring_ swig_type_106;
+typedef _gostring_ swig_type_107;
+typedef _gostring_ swig_type_108;
+typedef _gostring_ swig_type_109;
+typedef _gostring_ swig_type_110;
+typedef _gostring_ swig_type_111;
+typedef _gostring_ swig_type_112;
+typedef _gostring_ swig_type_113;
+typedef _gostring_ swig_type_114;
+typedef _gostring_ swig_type_115;
+typedef _gostring_ swig_type_116;
+typedef _gostring_ swig_type_117;
+typedef _gostring_ swig_type_118;
+typedef _gostring_ swig_type_119;
+typedef _gostring_ swig_type_120;
+typedef _gostring_ swig_type_121;
+typedef _gostring_ swig_type_122;
+typedef _gostring_ swig_type_123;
+typedef _gostring_ swig_type_124;
+typedef _gostring_ swig_type_125;
+typedef _gostring_ swig_type_126;
+typedef _gostring_ swig_type_127;
+typedef _gostring_ swig_type_128;
+typedef _gostring_ swig_type_129;
+typedef _gostring_ swig_type_130;
+typedef _gostring_ swig_type_131;
+typedef _gostring_ swig_type_132;
+typedef _gostring_ swig_type_133;
+typedef _gostring_ swig_type_134;
+typedef _gostring_ swig_type_135;
+typedef _gostring_ swig_type_136;
+typedef _gostring_ swig_type_137;
+typedef _gostring_ swig_type_138;
+typedef _gostring_ swig_type_139;
+typedef _gostring_ swig_type_140;
+typedef _gostring_ swig_type_141;
+typedef _gostring_ swig_type_142;
+typedef _gostring_ swig_type_143;
+typedef _gostring_ swig_type_144;
+typedef _gostring_ swig_type_145;
+typedef _gostring_ swig_type_146;
+typedef _gostring_ swig_type_147;
+typedef _gostring_ swig_type_148;
+typedef _gostring_ swig_type_149;
+typedef _gostring_ swig_type_150;
+typedef _gostring_ swig_type_151;
+typedef _gostring_ swig_type_152;
+typedef _gostring_ swig_type_153;
+typedef _gostring_ swig_type_154;
+typedef _gostring_ swig_type_155;
+typedef _gostring_ swig_type_156;
+typedef _gostring_ swig_type_157;
+typedef _gostring_ swig_type_158;
+typedef _gostring_ swig_type_159;
+typedef _gostring_ swig_type_160;
+typedef _gostring_ swig_type_161;
+typedef _gostring_ swig_type_162;
+typedef _gostring_ swig_type_163;
+typedef _gostring_ swig_type_164;
+typedef _gostring_ swig_type_165;
+typedef _gostring_ swig_type_166;
+typedef _gostring_ swig_type_167;
+typedef _gostring_ swig_type_168;
+typedef _gostring_ swig_type_169;
+typedef _gostring_ swig_type_170;
+typedef _gostring_ swig_type_171;
+typedef _gostring_ swig_type_172;
+typedef _gostring_ swig_type_173;
+typedef _gostring_ swig_type_174;
+typedef _gostring_ swig_type_175;
 extern void _wrap_Swig_free_cfdgo_a23e02774b82509b(uintptr_t arg1);
 extern uintptr_t _wrap_Swig_malloc_cfdgo_a23e02774b82509b(swig_intgo arg1);
 extern swig_intgo _wrap_kCfdSuccess_cfdgo_a23e02774b82509b(void);
@@ -127,6 +219,7 @@ extern swig_intgo _wrap_kCfdOutOfRangeError_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdInvalidSettingError_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdConnectionError_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdDiskAccessError_cfdgo_a23e02774b82509b(void);
+extern swig_intgo _wrap_kCfdSignVerificationError_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdEnableBitcoin_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdEnableElements_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_CfdGetSupportedFunction_cfdgo_a23e02774b82509b(uintptr_t arg1);
@@ -182,52 +275,103 @@ extern swig_intgo _wrap_CfdParseDescriptor_cfdgo_a23e02774b82509b(uintptr_t arg1
 extern swig_intgo _wrap_CfdGetDescriptorData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, uintptr_t arg4, uintptr_t arg5, swig_voidp arg6, swig_voidp arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10, swig_voidp arg11, swig_voidp arg12, swig_voidp arg13, swig_voidp arg14, swig_voidp arg15, uintptr_t arg16);
 extern swig_intgo _wrap_CfdGetDescriptorMultisigKey_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4, swig_voidp arg5, swig_voidp arg6, swig_voidp arg7);
 extern swig_intgo _wrap_CfdFreeDescriptorHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
-extern swig_intgo _wrap_CfdGetAddressesFromMultisig_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_6 arg2, swig_intgo arg3, swig_intgo arg4, swig_voidp arg5, uintptr_t arg6);
+extern swig_intgo _wrap_CfdGetDescriptorChecksum_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_type_6 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdGetAddressesFromMultisig_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_7 arg2, swig_intgo arg3, swig_intgo arg4, swig_voidp arg5, uintptr_t arg6);
 extern swig_intgo _wrap_CfdGetAddressFromMultisigKey_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4, swig_voidp arg5);
 extern swig_intgo _wrap_CfdFreeAddressesMultisigHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
-extern swig_intgo _wrap_CfdCreateConfidentialAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_7 arg2, swig_type_8 arg3, swig_voidp arg4);
-extern swig_intgo _wrap_CfdParseConfidentialAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_9 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdGetAddressFromLockingScript_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_8 arg2, swig_intgo arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdGetAddressInfo_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_9 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5, swig_voidp arg6, swig_voidp arg7);
+extern swig_intgo _wrap_CfdCreateConfidentialAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_10 arg2, swig_type_11 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdParseConfidentialAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_12 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdGetPeginAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_type_13 arg3, swig_intgo arg4, swig_type_14 arg5, swig_type_15 arg6, swig_voidp arg7, swig_voidp arg8, swig_voidp arg9);
+extern swig_intgo _wrap_CfdGetPegoutAddress_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_type_16 arg4, uintptr_t arg5, swig_intgo arg6, swig_voidp arg7, swig_voidp arg8);
 extern swig_intgo _wrap_CfdInitializeConfidentialTx_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4);
-extern swig_intgo _wrap_CfdAddConfidentialTxIn_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_10 arg2, swig_type_11 arg3, uintptr_t arg4, uintptr_t arg5, swig_voidp arg6);
-extern swig_intgo _wrap_CfdAddConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_12 arg2, swig_type_13 arg3, uintptr_t arg4, swig_type_14 arg5, swig_type_15 arg6, swig_type_16 arg7, swig_type_17 arg8, swig_voidp arg9);
-extern swig_intgo _wrap_CfdUpdateConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_18 arg2, uintptr_t arg3, swig_type_19 arg4, uintptr_t arg5, swig_type_20 arg6, swig_type_21 arg7, swig_type_22 arg8, swig_type_23 arg9, swig_voidp arg10);
-extern swig_intgo _wrap_CfdGetConfidentialTxIn_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_24 arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, uintptr_t arg6, swig_voidp arg7);
-extern swig_intgo _wrap_CfdGetConfidentialTxInWitness_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_25 arg2, uintptr_t arg3, uintptr_t arg4, swig_voidp arg5);
-extern swig_intgo _wrap_CfdGetTxInIssuanceInfo_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_26 arg2, uintptr_t arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, swig_voidp arg7, uintptr_t arg8, swig_voidp arg9, swig_voidp arg10, swig_voidp arg11);
-extern swig_intgo _wrap_CfdGetConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_27 arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, swig_voidp arg6, swig_voidp arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10);
-extern swig_intgo _wrap_CfdGetConfidentialTxInCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_28 arg2, uintptr_t arg3);
-extern swig_intgo _wrap_CfdGetConfidentialTxInWitnessCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_29 arg2, uintptr_t arg3, uintptr_t arg4);
-extern swig_intgo _wrap_CfdGetConfidentialTxOutCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_30 arg2, uintptr_t arg3);
-extern swig_intgo _wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_31 arg2, swig_type_32 arg3, uintptr_t arg4, uintptr_t arg5, swig_type_33 arg6, swig_type_34 arg7, swig_type_35 arg8, swig_type_36 arg9, swig_voidp arg10, swig_voidp arg11);
-extern swig_intgo _wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_37 arg2, swig_type_38 arg3, uintptr_t arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdAddConfidentialTxIn_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_17 arg2, swig_type_18 arg3, uintptr_t arg4, uintptr_t arg5, swig_voidp arg6);
+extern swig_intgo _wrap_CfdAddConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_19 arg2, swig_type_20 arg3, uintptr_t arg4, swig_type_21 arg5, swig_type_22 arg6, swig_type_23 arg7, swig_type_24 arg8, swig_voidp arg9);
+extern swig_intgo _wrap_CfdUpdateConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_25 arg2, uintptr_t arg3, swig_type_26 arg4, uintptr_t arg5, swig_type_27 arg6, swig_type_28 arg7, swig_type_29 arg8, swig_type_30 arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdGetConfidentialTxInfo_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_31 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, uintptr_t arg7, uintptr_t arg8, uintptr_t arg9, uintptr_t arg10);
+extern swig_intgo _wrap_CfdGetConfidentialTxIn_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_32 arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, uintptr_t arg6, swig_voidp arg7);
+extern swig_intgo _wrap_CfdGetConfidentialTxInWitness_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_33 arg2, uintptr_t arg3, uintptr_t arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdGetTxInIssuanceInfo_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_34 arg2, uintptr_t arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, swig_voidp arg7, uintptr_t arg8, swig_voidp arg9, swig_voidp arg10, swig_voidp arg11);
+extern swig_intgo _wrap_CfdGetConfidentialTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_35 arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, swig_voidp arg6, swig_voidp arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdGetConfidentialTxInCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_36 arg2, uintptr_t arg3);
+extern swig_intgo _wrap_CfdGetConfidentialTxInWitnessCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_37 arg2, uintptr_t arg3, uintptr_t arg4);
+extern swig_intgo _wrap_CfdGetConfidentialTxOutCount_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_38 arg2, uintptr_t arg3);
+extern swig_intgo _wrap_CfdInitializeTxDataHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_type_39 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdFreeTxDataHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
+extern swig_intgo _wrap_CfdGetConfidentialTxInfoByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, uintptr_t arg7, uintptr_t arg8, uintptr_t arg9, uintptr_t arg10);
+extern swig_intgo _wrap_CfdGetTxInByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, uintptr_t arg6, swig_voidp arg7);
+extern swig_intgo _wrap_CfdGetTxInIssuanceInfoByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, swig_voidp arg7, uintptr_t arg8, swig_voidp arg9, swig_voidp arg10, swig_voidp arg11);
+extern swig_intgo _wrap_CfdGetTxInWitnessByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_intgo arg3, uintptr_t arg4, uintptr_t arg5, swig_voidp arg6);
+extern swig_intgo _wrap_CfdGetConfidentialTxOutByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4, uintptr_t arg5, swig_voidp arg6, swig_voidp arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdGetTxInCountByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3);
+extern swig_intgo _wrap_CfdGetTxInWitnessCountByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_intgo arg3, uintptr_t arg4, uintptr_t arg5);
+extern swig_intgo _wrap_CfdGetTxOutCountByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3);
+extern swig_intgo _wrap_CfdGetTxInIndexByHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_40 arg3, uintptr_t arg4, uintptr_t arg5);
+extern swig_intgo _wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_41 arg2, swig_type_42 arg3, uintptr_t arg4, uintptr_t arg5, swig_type_43 arg6, swig_type_44 arg7, swig_type_45 arg8, swig_type_46 arg9, swig_voidp arg10, swig_voidp arg11);
+extern swig_intgo _wrap_CfdSetRawIssueAsset_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_47 arg2, swig_type_48 arg3, uintptr_t arg4, swig_type_49 arg5, uintptr_t arg6, swig_type_50 arg7, swig_type_51 arg8, uintptr_t arg9, swig_type_52 arg10, swig_type_53 arg11, _Bool arg12, swig_voidp arg13, swig_voidp arg14, swig_voidp arg15, swig_voidp arg16);
+extern swig_intgo _wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_54 arg2, swig_type_55 arg3, uintptr_t arg4, swig_voidp arg5);
 extern swig_intgo _wrap_CfdInitializeBlindTx_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_voidp arg2);
-extern swig_intgo _wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_39 arg3, uintptr_t arg4, swig_type_40 arg5, swig_type_41 arg6, swig_type_42 arg7, uintptr_t arg8, swig_type_43 arg9, swig_type_44 arg10);
-extern swig_intgo _wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_type_45 arg4);
-extern swig_intgo _wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_46 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_56 arg3, uintptr_t arg4, swig_type_57 arg5, swig_type_58 arg6, swig_type_59 arg7, uintptr_t arg8, swig_type_60 arg9, swig_type_61 arg10);
+extern swig_intgo _wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_type_62 arg4);
+extern swig_intgo _wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_63 arg3, swig_voidp arg4);
 extern swig_intgo _wrap_CfdFreeBlindHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
-extern swig_intgo _wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_47 arg2, swig_type_48 arg3, uintptr_t arg4, _Bool arg5, swig_type_49 arg6, _Bool arg7, swig_voidp arg8);
-extern swig_intgo _wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_50 arg2, swig_type_51 arg3, uintptr_t arg4, _Bool arg5, swig_type_52 arg6, swig_intgo arg7, _Bool arg8, _Bool arg9, swig_voidp arg10);
-extern swig_intgo _wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_53 arg3, swig_type_54 arg4, uintptr_t arg5, swig_intgo arg6, swig_type_55 arg7, swig_type_56 arg8, _Bool arg9, swig_voidp arg10);
-extern swig_intgo _wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_57 arg2, swig_type_58 arg3, uintptr_t arg4, swig_intgo arg5, swig_type_59 arg6, swig_type_60 arg7, uintptr_t arg8, swig_type_61 arg9, swig_intgo arg10, _Bool arg11, swig_voidp arg12);
-extern swig_intgo _wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_62 arg2, uintptr_t arg3, swig_type_63 arg4, swig_voidp arg5, uintptr_t arg6, swig_voidp arg7, swig_voidp arg8);
-extern swig_intgo _wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_64 arg2, uintptr_t arg3, swig_type_65 arg4, swig_type_66 arg5, swig_voidp arg6, uintptr_t arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10, uintptr_t arg11, swig_voidp arg12, swig_voidp arg13);
+extern swig_intgo _wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_64 arg2, swig_type_65 arg3, uintptr_t arg4, _Bool arg5, swig_type_66 arg6, _Bool arg7, swig_voidp arg8);
+extern swig_intgo _wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_67 arg2, swig_type_68 arg3, uintptr_t arg4, _Bool arg5, swig_type_69 arg6, swig_intgo arg7, _Bool arg8, _Bool arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_70 arg3, swig_type_71 arg4, uintptr_t arg5, swig_intgo arg6, swig_type_72 arg7, swig_type_73 arg8, _Bool arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_74 arg2, swig_type_75 arg3, uintptr_t arg4, swig_intgo arg5, swig_type_76 arg6, swig_type_77 arg7, uintptr_t arg8, swig_type_78 arg9, swig_intgo arg10, _Bool arg11, swig_voidp arg12);
+extern swig_intgo _wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_79 arg2, uintptr_t arg3, swig_type_80 arg4, swig_voidp arg5, uintptr_t arg6, swig_voidp arg7, swig_voidp arg8);
+extern swig_intgo _wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_81 arg2, uintptr_t arg3, swig_type_82 arg4, swig_type_83 arg5, swig_voidp arg6, uintptr_t arg7, swig_voidp arg8, swig_voidp arg9, swig_voidp arg10, uintptr_t arg11, swig_voidp arg12, swig_voidp arg13);
+extern swig_intgo _wrap_CfdVerifyConfidentialTxSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_84 arg2, swig_type_85 arg3, uintptr_t arg4, swig_type_86 arg5, swig_intgo arg6, swig_type_87 arg7, uintptr_t arg8, swig_type_88 arg9);
+extern swig_intgo _wrap_CfdVerifyConfidentialTxSignature_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_89 arg2, swig_type_90 arg3, swig_type_91 arg4, swig_type_92 arg5, swig_type_93 arg6, uintptr_t arg7, swig_intgo arg8, _Bool arg9, uintptr_t arg10, swig_type_94 arg11, swig_intgo arg12);
 extern swig_intgo _wrap_kCfdExtPrivkey_cfdgo_a23e02774b82509b(void);
 extern swig_intgo _wrap_kCfdExtPubkey_cfdgo_a23e02774b82509b(void);
-extern swig_intgo _wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_67 arg2, swig_type_68 arg3, swig_type_69 arg4, swig_intgo arg5, _Bool arg6, swig_voidp arg7);
+extern swig_intgo _wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_95 arg2, swig_type_96 arg3, swig_type_97 arg4, swig_intgo arg5, _Bool arg6, swig_voidp arg7);
+extern swig_intgo _wrap_CfdVerifyEcSignature_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_98 arg2, swig_type_99 arg3, swig_type_100 arg4);
+extern swig_intgo _wrap_CfdEncodeSignatureByDer_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_101 arg2, swig_intgo arg3, _Bool arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdDecodeSignatureFromDer_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_102 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdNormalizeSignature_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_103 arg2, swig_voidp arg3);
+extern swig_intgo _wrap_CfdSignSchnorr_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_104 arg2, swig_type_105 arg3, swig_type_106 arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdVerifySchnorr_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_107 arg2, swig_type_108 arg3, swig_type_109 arg4);
+extern swig_intgo _wrap_CfdGetSchnorrPubkeyFromPrivkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_110 arg2, swig_voidp arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdGetSchnorrPubkeyFromPubkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_111 arg2, swig_voidp arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdSchnorrPubkeyTweakAdd_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_112 arg2, swig_type_113 arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdSchnorrKeyPairTweakAdd_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_114 arg2, swig_type_115 arg3, swig_voidp arg4, swig_voidp arg5, swig_voidp arg6);
+extern swig_intgo _wrap_CfdCheckTweakAddFromSchnorrPubkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_116 arg2, _Bool arg3, swig_type_117 arg4, swig_type_118 arg5);
+extern swig_intgo _wrap_CfdPubkeyTweakAdd_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_119 arg2, swig_type_120 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdCompressPubkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_121 arg2, swig_voidp arg3);
 extern swig_intgo _wrap_CfdCreateKeyPair_cfdgo_a23e02774b82509b(uintptr_t arg1, _Bool arg2, swig_intgo arg3, swig_voidp arg4, swig_voidp arg5, swig_voidp arg6);
-extern swig_intgo _wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_70 arg2, swig_intgo arg3, swig_voidp arg4);
-extern swig_intgo _wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_71 arg2, swig_type_72 arg3, _Bool arg4, swig_voidp arg5);
-extern swig_intgo _wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_73 arg2, swig_intgo arg3, swig_intgo arg4, swig_voidp arg5);
-extern swig_intgo _wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_74 arg2, swig_type_75 arg3, swig_intgo arg4, swig_intgo arg5, swig_voidp arg6);
-extern swig_intgo _wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_76 arg2, swig_intgo arg3, swig_voidp arg4);
-extern swig_intgo _wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_77 arg2, swig_intgo arg3, swig_voidp arg4, swig_voidp arg5);
-extern swig_intgo _wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_78 arg2, swig_intgo arg3, swig_voidp arg4);
-extern swig_intgo _wrap_CfdParseScript_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_79 arg2, swig_voidp arg3, uintptr_t arg4);
+extern swig_intgo _wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_122 arg2, swig_intgo arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdParsePrivkeyWif_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_123 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_124 arg2, swig_type_125 arg3, _Bool arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_126 arg2, swig_intgo arg3, swig_intgo arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_127 arg2, swig_type_128 arg3, swig_intgo arg4, swig_intgo arg5, swig_voidp arg6);
+extern swig_intgo _wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_129 arg2, swig_intgo arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_130 arg2, swig_intgo arg3, swig_voidp arg4, swig_voidp arg5);
+extern swig_intgo _wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_131 arg2, swig_intgo arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdCreateExtkey_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_intgo arg3, swig_type_132 arg4, swig_type_133 arg5, swig_type_134 arg6, swig_type_135 arg7, char arg8, uintptr_t arg9, swig_voidp arg10);
+extern swig_intgo _wrap_CfdGetExtkeyInformation_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_136 arg2, swig_voidp arg3, swig_voidp arg4, swig_voidp arg5, uintptr_t arg6, uintptr_t arg7);
+extern swig_intgo _wrap_CfdInitializeMnemonicWordList_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_137 arg2, swig_voidp arg3, uintptr_t arg4);
+extern swig_intgo _wrap_CfdGetMnemonicWord_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdFreeMnemonicWordList_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
+extern swig_intgo _wrap_CfdConvertMnemonicToSeed_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_138 arg2, swig_type_139 arg3, _Bool arg4, swig_type_140 arg5, _Bool arg6, swig_voidp arg7, swig_voidp arg8);
+extern swig_intgo _wrap_CfdConvertEntropyToMnemonic_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_141 arg2, swig_type_142 arg3, swig_voidp arg4);
+extern swig_intgo _wrap_CfdParseScript_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_type_143 arg2, swig_voidp arg3, uintptr_t arg4);
 extern swig_intgo _wrap_CfdGetScriptItem_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_voidp arg4);
 extern swig_intgo _wrap_CfdFreeScriptItemHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
+extern swig_intgo _wrap_CfdInitializeTransaction_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, uintptr_t arg3, uintptr_t arg4, swig_type_144 arg5, swig_voidp arg6);
+extern swig_intgo _wrap_CfdAddTransactionInput_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_145 arg3, uintptr_t arg4, uintptr_t arg5);
+extern swig_intgo _wrap_CfdAddTransactionOutput_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, uintptr_t arg3, swig_type_146 arg4, swig_type_147 arg5, swig_type_148 arg6);
+extern swig_intgo _wrap_CfdAddTxPeginInput_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_149 arg3, uintptr_t arg4, uintptr_t arg5, swig_type_150 arg6, swig_type_151 arg7, swig_type_152 arg8, swig_type_153 arg9, swig_type_154 arg10);
+extern swig_intgo _wrap_CfdAddTxPegoutOutput_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_155 arg3, uintptr_t arg4, swig_intgo arg5, swig_intgo arg6, swig_type_156 arg7, swig_type_157 arg8, swig_type_158 arg9, swig_type_159 arg10, uintptr_t arg11, swig_type_160 arg12, swig_voidp arg13);
+extern swig_intgo _wrap_CfdFinalizeTransaction_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_voidp arg3);
+extern swig_intgo _wrap_CfdFreeTransactionHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
+extern swig_intgo _wrap_CfdCreateSighash_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_type_161 arg3, swig_type_162 arg4, uintptr_t arg5, swig_intgo arg6, swig_type_163 arg7, swig_type_164 arg8, uintptr_t arg9, swig_intgo arg10, _Bool arg11, swig_voidp arg12);
+extern swig_intgo _wrap_CfdAddTxSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_intgo arg2, swig_type_165 arg3, swig_type_166 arg4, uintptr_t arg5, swig_intgo arg6, swig_type_167 arg7, _Bool arg8, swig_intgo arg9, _Bool arg10, _Bool arg11, swig_voidp arg12);
 extern swig_intgo _wrap_CfdInitializeMultisigSign_cfdgo_a23e02774b82509b(uintptr_t arg1, swig_voidp arg2);
-extern swig_intgo _wrap_CfdAddMultisigSignData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_80 arg3, swig_type_81 arg4);
-extern swig_intgo _wrap_CfdAddMultisigSignDataToDer_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_82 arg3, swig_intgo arg4, _Bool arg5, swig_type_83 arg6);
+extern swig_intgo _wrap_CfdAddMultisigSignData_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_168 arg3, swig_type_169 arg4);
+extern swig_intgo _wrap_CfdAddMultisigSignDataToDer_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_type_170 arg3, swig_intgo arg4, _Bool arg5, swig_type_171 arg6);
+extern swig_intgo _wrap_CfdFinalizeMultisigSign_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2, swig_intgo arg3, swig_type_172 arg4, swig_type_173 arg5, uintptr_t arg6, swig_intgo arg7, swig_type_174 arg8, swig_type_175 arg9, _Bool arg10, swig_voidp arg11);
 extern swig_intgo _wrap_CfdFreeMultisigSignHandle_cfdgo_a23e02774b82509b(uintptr_t arg1, uintptr_t arg2);
 #undef intgo
 */
@@ -236,7 +380,10 @@ import "C"
 import "unsafe"
 import _ "runtime/cgo"
 import "sync"
+import "errors"
 import "fmt"
+import "strconv"
+import "strings"
 
 
 type _ unsafe.Pointer
@@ -336,6 +483,13 @@ func _swig_getkCfdDiskAccessError() (_swig_ret Enum_SS_CfdErrorCode) {
 }
 
 var KCfdDiskAccessError Enum_SS_CfdErrorCode = _swig_getkCfdDiskAccessError()
+func _swig_getkCfdSignVerificationError() (_swig_ret Enum_SS_CfdErrorCode) {
+	var swig_r Enum_SS_CfdErrorCode
+	swig_r = (Enum_SS_CfdErrorCode)(C._wrap_kCfdSignVerificationError_cfdgo_a23e02774b82509b())
+	return swig_r
+}
+
+var KCfdSignVerificationError Enum_SS_CfdErrorCode = _swig_getkCfdSignVerificationError()
 type Enum_SS_CfdLibraryFunction int
 func _swig_getkCfdEnableBitcoin() (_swig_ret Enum_SS_CfdLibraryFunction) {
 	var swig_r Enum_SS_CfdLibraryFunction
@@ -788,6 +942,19 @@ func CfdFreeDescriptorHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
 	return swig_r
 }
 
+func CfdGetDescriptorChecksum(arg1 uintptr, arg2 int, arg3 string, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetDescriptorChecksum_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), *(*C.swig_type_6)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
 func CfdGetAddressesFromMultisig(arg1 uintptr, arg2 string, arg3 int, arg4 int, arg5 *uintptr, arg6 Uint32_t) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -796,7 +963,7 @@ func CfdGetAddressesFromMultisig(arg1 uintptr, arg2 string, arg3 int, arg4 int,
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
 	_swig_i_5 := arg6.Swigcptr()
-	swig_r = (int)(C._wrap_CfdGetAddressesFromMultisig_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_6)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5)))
+	swig_r = (int)(C._wrap_CfdGetAddressesFromMultisig_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_7)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -822,13 +989,42 @@ func CfdFreeAddressesMultisigHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int)
 	return swig_r
 }
 
+func CfdGetAddressFromLockingScript(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetAddressFromLockingScript_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_8)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetAddressInfo(arg1 uintptr, arg2 string, arg3 *int, arg4 *int, arg5 *int, arg6 *string, arg7 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	swig_r = (int)(C._wrap_CfdGetAddressInfo_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_9)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.swig_voidp(_swig_i_5), C.swig_voidp(_swig_i_6)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
 func CfdCreateConfidentialAddress(arg1 uintptr, arg2 string, arg3 string, arg4 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdCreateConfidentialAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_7)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_8)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
+	swig_r = (int)(C._wrap_CfdCreateConfidentialAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_10)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_11)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -845,13 +1041,54 @@ func CfdParseConfidentialAddress(arg1 uintptr, arg2 string, arg3 *string, arg4 *
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdParseConfidentialAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_9)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdParseConfidentialAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_12)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
 	return swig_r
 }
 
+func CfdGetPeginAddress(arg1 uintptr, arg2 int, arg3 string, arg4 int, arg5 string, arg6 string, arg7 *string, arg8 *string, arg9 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	swig_r = (int)(C._wrap_CfdGetPeginAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), *(*C.swig_type_13)(unsafe.Pointer(&_swig_i_2)), C.swig_intgo(_swig_i_3), *(*C.swig_type_14)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_15)(unsafe.Pointer(&_swig_i_5)), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	return swig_r
+}
+
+func CfdGetPegoutAddress(arg1 uintptr, arg2 int, arg3 int, arg4 string, arg5 Uint32_t, arg6 int, arg7 *string, arg8 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	swig_r = (int)(C._wrap_CfdGetPegoutAddress_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), *(*C.swig_type_16)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), C.swig_intgo(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	return swig_r
+}
+
 func CfdInitializeConfidentialTx(arg1 uintptr, arg2 Uint32_t, arg3 Uint32_t, arg4 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -870,7 +1107,7 @@ func CfdAddConfidentialTxIn(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_
 	_swig_i_3 := arg4.Swigcptr()
 	_swig_i_4 := arg5.Swigcptr()
 	_swig_i_5 := arg6
-	swig_r = (int)(C._wrap_CfdAddConfidentialTxIn_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_10)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_11)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5)))
+	swig_r = (int)(C._wrap_CfdAddConfidentialTxIn_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_17)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_18)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -891,7 +1128,7 @@ func CfdAddConfidentialTxOut(arg1 uintptr, arg2 string, arg3 string, arg4 Int64_
 	_swig_i_6 := arg7
 	_swig_i_7 := arg8
 	_swig_i_8 := arg9
-	swig_r = (int)(C._wrap_CfdAddConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_12)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_13)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_14)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_15)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_16)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_17)(unsafe.Pointer(&_swig_i_7)), C.swig_voidp(_swig_i_8)))
+	swig_r = (int)(C._wrap_CfdAddConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_19)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_20)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_21)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_22)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_23)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_24)(unsafe.Pointer(&_swig_i_7)), C.swig_voidp(_swig_i_8)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -925,7 +1162,7 @@ func CfdUpdateConfidentialTxOut(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 s
 	_swig_i_7 := arg8
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
-	swig_r = (int)(C._wrap_CfdUpdateConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_18)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_19)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), *(*C.swig_type_20)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_21)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_22)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_23)(unsafe.Pointer(&_swig_i_8)), C.swig_voidp(_swig_i_9)))
+	swig_r = (int)(C._wrap_CfdUpdateConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_25)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_26)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), *(*C.swig_type_27)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_28)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_29)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_30)(unsafe.Pointer(&_swig_i_8)), C.swig_voidp(_swig_i_9)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -947,6 +1184,25 @@ func CfdUpdateConfidentialTxOut(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 s
 	return swig_r
 }
 
+func CfdGetConfidentialTxInfo(arg1 uintptr, arg2 string, arg3 *string, arg4 *string, arg5 *string, arg6 Uint32_t, arg7 Uint32_t, arg8 Uint32_t, arg9 Uint32_t, arg10 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7.Swigcptr()
+	_swig_i_7 := arg8.Swigcptr()
+	_swig_i_8 := arg9.Swigcptr()
+	_swig_i_9 := arg10.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxInfo_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_31)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.uintptr_t(_swig_i_6), C.uintptr_t(_swig_i_7), C.uintptr_t(_swig_i_8), C.uintptr_t(_swig_i_9)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
 func CfdGetConfidentialTxIn(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 *string, arg5 Uint32_t, arg6 Uint32_t, arg7 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -956,7 +1212,7 @@ func CfdGetConfidentialTxIn(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 *stri
 	_swig_i_4 := arg5.Swigcptr()
 	_swig_i_5 := arg6.Swigcptr()
 	_swig_i_6 := arg7
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxIn_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_24)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxIn_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_32)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -970,7 +1226,7 @@ func CfdGetConfidentialTxInWitness(arg1 uintptr, arg2 string, arg3 Uint32_t, arg
 	_swig_i_2 := arg3.Swigcptr()
 	_swig_i_3 := arg4.Swigcptr()
 	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxInWitness_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_25)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.uintptr_t(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxInWitness_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_33)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.uintptr_t(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -990,7 +1246,7 @@ func CfdGetTxInIssuanceInfo(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 *stri
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
 	_swig_i_10 := arg11
-	swig_r = (int)(C._wrap_CfdGetTxInIssuanceInfo_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_26)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6), C.uintptr_t(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9), C.swig_voidp(_swig_i_10)))
+	swig_r = (int)(C._wrap_CfdGetTxInIssuanceInfo_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_34)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6), C.uintptr_t(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9), C.swig_voidp(_swig_i_10)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1009,7 +1265,7 @@ func CfdGetConfidentialTxOut(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 *str
 	_swig_i_7 := arg8
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_27)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_35)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1021,7 +1277,7 @@ func CfdGetConfidentialTxInCount(arg1 uintptr, arg2 string, arg3 Uint32_t) (_swi
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3.Swigcptr()
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxInCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_28)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxInCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_36)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1034,7 +1290,7 @@ func CfdGetConfidentialTxInWitnessCount(arg1 uintptr, arg2 string, arg3 Uint32_t
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3.Swigcptr()
 	_swig_i_3 := arg4.Swigcptr()
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxInWitnessCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_29)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.uintptr_t(_swig_i_3)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxInWitnessCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_37)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), C.uintptr_t(_swig_i_3)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1046,13 +1302,151 @@ func CfdGetConfidentialTxOutCount(arg1 uintptr, arg2 string, arg3 Uint32_t) (_sw
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3.Swigcptr()
-	swig_r = (int)(C._wrap_CfdGetConfidentialTxOutCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_30)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2)))
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxOutCount_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_38)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
 	return swig_r
 }
 
+func CfdInitializeTxDataHandle(arg1 uintptr, arg2 int, arg3 string, arg4 *uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdInitializeTxDataHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), *(*C.swig_type_39)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
+func CfdFreeTxDataHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdFreeTxDataHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+func CfdGetConfidentialTxInfoByHandle(arg1 uintptr, arg2 uintptr, arg3 *string, arg4 *string, arg5 *string, arg6 Uint32_t, arg7 Uint32_t, arg8 Uint32_t, arg9 Uint32_t, arg10 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7.Swigcptr()
+	_swig_i_7 := arg8.Swigcptr()
+	_swig_i_8 := arg9.Swigcptr()
+	_swig_i_9 := arg10.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxInfoByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.uintptr_t(_swig_i_6), C.uintptr_t(_swig_i_7), C.uintptr_t(_swig_i_8), C.uintptr_t(_swig_i_9)))
+	return swig_r
+}
+
+func CfdGetTxInByHandle(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string, arg5 Uint32_t, arg6 Uint32_t, arg7 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7
+	swig_r = (int)(C._wrap_CfdGetTxInByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6)))
+	return swig_r
+}
+
+func CfdGetTxInIssuanceInfoByHandle(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string, arg5 *string, arg6 Int64_t, arg7 *string, arg8 Int64_t, arg9 *string, arg10 *string, arg11 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8.Swigcptr()
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11
+	swig_r = (int)(C._wrap_CfdGetTxInIssuanceInfoByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6), C.uintptr_t(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9), C.swig_voidp(_swig_i_10)))
+	return swig_r
+}
+
+func CfdGetTxInWitnessByHandle(arg1 uintptr, arg2 uintptr, arg3 int, arg4 Uint32_t, arg5 Uint32_t, arg6 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdGetTxInWitnessByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_intgo(_swig_i_2), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5)))
+	return swig_r
+}
+
+func CfdGetConfidentialTxOutByHandle(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string, arg5 Int64_t, arg6 *string, arg7 *string, arg8 *string, arg9 *string, arg10 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	swig_r = (int)(C._wrap_CfdGetConfidentialTxOutByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3), C.uintptr_t(_swig_i_4), C.swig_voidp(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9)))
+	return swig_r
+}
+
+func CfdGetTxInCountByHandle(arg1 uintptr, arg2 uintptr, arg3 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetTxInCountByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2)))
+	return swig_r
+}
+
+func CfdGetTxInWitnessCountByHandle(arg1 uintptr, arg2 uintptr, arg3 int, arg4 Uint32_t, arg5 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetTxInWitnessCountByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_intgo(_swig_i_2), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4)))
+	return swig_r
+}
+
+func CfdGetTxOutCountByHandle(arg1 uintptr, arg2 uintptr, arg3 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetTxOutCountByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2)))
+	return swig_r
+}
+
+func CfdGetTxInIndexByHandle(arg1 uintptr, arg2 uintptr, arg3 string, arg4 Uint32_t, arg5 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetTxInIndexByHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_40)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
 func CfdSetRawReissueAsset(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t, arg5 Int64_t, arg6 string, arg7 string, arg8 string, arg9 string, arg10 *string, arg11 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -1066,7 +1460,7 @@ func CfdSetRawReissueAsset(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
 	_swig_i_10 := arg11
-	swig_r = (int)(C._wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_31)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_32)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), *(*C.swig_type_33)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_34)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_35)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_36)(unsafe.Pointer(&_swig_i_8)), C.swig_voidp(_swig_i_9), C.swig_voidp(_swig_i_10)))
+	swig_r = (int)(C._wrap_CfdSetRawReissueAsset_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_41)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_42)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), *(*C.swig_type_43)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_44)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_45)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_46)(unsafe.Pointer(&_swig_i_8)), C.swig_voidp(_swig_i_9), C.swig_voidp(_swig_i_10)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1088,6 +1482,49 @@ func CfdSetRawReissueAsset(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t
 	return swig_r
 }
 
+func CfdSetRawIssueAsset(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t, arg5 string, arg6 Int64_t, arg7 string, arg8 string, arg9 Int64_t, arg10 string, arg11 string, arg12 bool, arg13 *string, arg14 *string, arg15 *string, arg16 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9.Swigcptr()
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11
+	_swig_i_11 := arg12
+	_swig_i_12 := arg13
+	_swig_i_13 := arg14
+	_swig_i_14 := arg15
+	_swig_i_15 := arg16
+	swig_r = (int)(C._wrap_CfdSetRawIssueAsset_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_47)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_48)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_49)(unsafe.Pointer(&_swig_i_4)), C.uintptr_t(_swig_i_5), *(*C.swig_type_50)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_51)(unsafe.Pointer(&_swig_i_7)), C.uintptr_t(_swig_i_8), *(*C.swig_type_52)(unsafe.Pointer(&_swig_i_9)), *(*C.swig_type_53)(unsafe.Pointer(&_swig_i_10)), C._Bool(_swig_i_11), C.swig_voidp(_swig_i_12), C.swig_voidp(_swig_i_13), C.swig_voidp(_swig_i_14), C.swig_voidp(_swig_i_15)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg8
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg10
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg11
+	}
+	return swig_r
+}
+
 func CfdGetIssuanceBlindingKey(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t, arg5 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
@@ -1095,7 +1532,7 @@ func CfdGetIssuanceBlindingKey(arg1 uintptr, arg2 string, arg3 string, arg4 Uint
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4.Swigcptr()
 	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_37)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_38)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdGetIssuanceBlindingKey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_54)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_55)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1125,7 +1562,7 @@ func CfdAddBlindTxInData(arg1 uintptr, arg2 uintptr, arg3 string, arg4 Uint32_t,
 	_swig_i_7 := arg8.Swigcptr()
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
-	swig_r = (int)(C._wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_39)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_40)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_41)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_42)(unsafe.Pointer(&_swig_i_6)), C.uintptr_t(_swig_i_7), *(*C.swig_type_43)(unsafe.Pointer(&_swig_i_8)), *(*C.swig_type_44)(unsafe.Pointer(&_swig_i_9))))
+	swig_r = (int)(C._wrap_CfdAddBlindTxInData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_56)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_57)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_58)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_59)(unsafe.Pointer(&_swig_i_6)), C.uintptr_t(_swig_i_7), *(*C.swig_type_60)(unsafe.Pointer(&_swig_i_8)), *(*C.swig_type_61)(unsafe.Pointer(&_swig_i_9))))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
@@ -1153,7 +1590,7 @@ func CfdAddBlindTxOutData(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 string
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3.Swigcptr()
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), *(*C.swig_type_45)(unsafe.Pointer(&_swig_i_3))))
+	swig_r = (int)(C._wrap_CfdAddBlindTxOutData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), *(*C.swig_type_62)(unsafe.Pointer(&_swig_i_3))))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg4
 	}
@@ -1166,7 +1603,7 @@ func CfdFinalizeBlindTx(arg1 uintptr, arg2 uintptr, arg3 string, arg4 *string) (
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_46)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
+	swig_r = (int)(C._wrap_CfdFinalizeBlindTx_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_63)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
@@ -1191,7 +1628,7 @@ func CfdAddConfidentialTxSign(arg1 uintptr, arg2 string, arg3 string, arg4 Uint3
 	_swig_i_5 := arg6
 	_swig_i_6 := arg7
 	_swig_i_7 := arg8
-	swig_r = (int)(C._wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_47)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_48)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_49)(unsafe.Pointer(&_swig_i_5)), C._Bool(_swig_i_6), C.swig_voidp(_swig_i_7)))
+	swig_r = (int)(C._wrap_CfdAddConfidentialTxSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_64)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_65)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_66)(unsafe.Pointer(&_swig_i_5)), C._Bool(_swig_i_6), C.swig_voidp(_swig_i_7)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1216,7 +1653,7 @@ func CfdAddConfidentialTxDerSign(arg1 uintptr, arg2 string, arg3 string, arg4 Ui
 	_swig_i_7 := arg8
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
-	swig_r = (int)(C._wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_50)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_51)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_52)(unsafe.Pointer(&_swig_i_5)), C.swig_intgo(_swig_i_6), C._Bool(_swig_i_7), C._Bool(_swig_i_8), C.swig_voidp(_swig_i_9)))
+	swig_r = (int)(C._wrap_CfdAddConfidentialTxDerSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_67)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_68)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_69)(unsafe.Pointer(&_swig_i_5)), C.swig_intgo(_swig_i_6), C._Bool(_swig_i_7), C._Bool(_swig_i_8), C.swig_voidp(_swig_i_9)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1241,7 +1678,7 @@ func CfdFinalizeElementsMultisigSign(arg1 uintptr, arg2 uintptr, arg3 string, ar
 	_swig_i_7 := arg8
 	_swig_i_8 := arg9
 	_swig_i_9 := arg10
-	swig_r = (int)(C._wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_53)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_54)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), C.swig_intgo(_swig_i_5), *(*C.swig_type_55)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_56)(unsafe.Pointer(&_swig_i_7)), C._Bool(_swig_i_8), C.swig_voidp(_swig_i_9)))
+	swig_r = (int)(C._wrap_CfdFinalizeElementsMultisigSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_70)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_71)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), C.swig_intgo(_swig_i_5), *(*C.swig_type_72)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_73)(unsafe.Pointer(&_swig_i_7)), C._Bool(_swig_i_8), C.swig_voidp(_swig_i_9)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
@@ -1271,7 +1708,7 @@ func CfdCreateConfidentialSighash(arg1 uintptr, arg2 string, arg3 string, arg4 U
 	_swig_i_9 := arg10
 	_swig_i_10 := arg11
 	_swig_i_11 := arg12
-	swig_r = (int)(C._wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_57)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_58)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.swig_intgo(_swig_i_4), *(*C.swig_type_59)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_60)(unsafe.Pointer(&_swig_i_6)), C.uintptr_t(_swig_i_7), *(*C.swig_type_61)(unsafe.Pointer(&_swig_i_8)), C.swig_intgo(_swig_i_9), C._Bool(_swig_i_10), C.swig_voidp(_swig_i_11)))
+	swig_r = (int)(C._wrap_CfdCreateConfidentialSighash_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_74)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_75)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.swig_intgo(_swig_i_4), *(*C.swig_type_76)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_77)(unsafe.Pointer(&_swig_i_6)), C.uintptr_t(_swig_i_7), *(*C.swig_type_78)(unsafe.Pointer(&_swig_i_8)), C.swig_intgo(_swig_i_9), C._Bool(_swig_i_10), C.swig_voidp(_swig_i_11)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1300,7 +1737,7 @@ func CfdUnblindTxOut(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 string, arg5
 	_swig_i_5 := arg6.Swigcptr()
 	_swig_i_6 := arg7
 	_swig_i_7 := arg8
-	swig_r = (int)(C._wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_62)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_63)(unsafe.Pointer(&_swig_i_3)), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7)))
+	swig_r = (int)(C._wrap_CfdUnblindTxOut_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_79)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_80)(unsafe.Pointer(&_swig_i_3)), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1325,7 +1762,7 @@ func CfdUnblindIssuance(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 string, a
 	_swig_i_10 := arg11.Swigcptr()
 	_swig_i_11 := arg12
 	_swig_i_12 := arg13
-	swig_r = (int)(C._wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_64)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_65)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_66)(unsafe.Pointer(&_swig_i_4)), C.swig_voidp(_swig_i_5), C.uintptr_t(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9), C.uintptr_t(_swig_i_10), C.swig_voidp(_swig_i_11), C.swig_voidp(_swig_i_12)))
+	swig_r = (int)(C._wrap_CfdUnblindIssuance_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_81)(unsafe.Pointer(&_swig_i_1)), C.uintptr_t(_swig_i_2), *(*C.swig_type_82)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_83)(unsafe.Pointer(&_swig_i_4)), C.swig_voidp(_swig_i_5), C.uintptr_t(_swig_i_6), C.swig_voidp(_swig_i_7), C.swig_voidp(_swig_i_8), C.swig_voidp(_swig_i_9), C.uintptr_t(_swig_i_10), C.swig_voidp(_swig_i_11), C.swig_voidp(_swig_i_12)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1338,18 +1775,84 @@ func CfdUnblindIssuance(arg1 uintptr, arg2 string, arg3 Uint32_t, arg4 string, a
 	return swig_r
 }
 
-type Enum_SS_CfdExtKeyType int
-func _swig_getkCfdExtPrivkey() (_swig_ret Enum_SS_CfdExtKeyType) {
-	var swig_r Enum_SS_CfdExtKeyType
-	swig_r = (Enum_SS_CfdExtKeyType)(C._wrap_kCfdExtPrivkey_cfdgo_a23e02774b82509b())
-	return swig_r
-}
-
-var KCfdExtPrivkey Enum_SS_CfdExtKeyType = _swig_getkCfdExtPrivkey()
-func _swig_getkCfdExtPubkey() (_swig_ret Enum_SS_CfdExtKeyType) {
-	var swig_r Enum_SS_CfdExtKeyType
-	swig_r = (Enum_SS_CfdExtKeyType)(C._wrap_kCfdExtPubkey_cfdgo_a23e02774b82509b())
-	return swig_r
+func CfdVerifyConfidentialTxSign(arg1 uintptr, arg2 string, arg3 string, arg4 Uint32_t, arg5 string, arg6 int, arg7 string, arg8 Int64_t, arg9 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8.Swigcptr()
+	_swig_i_8 := arg9
+	swig_r = (int)(C._wrap_CfdVerifyConfidentialTxSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_84)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_85)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), *(*C.swig_type_86)(unsafe.Pointer(&_swig_i_4)), C.swig_intgo(_swig_i_5), *(*C.swig_type_87)(unsafe.Pointer(&_swig_i_6)), C.uintptr_t(_swig_i_7), *(*C.swig_type_88)(unsafe.Pointer(&_swig_i_8))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg9
+	}
+	return swig_r
+}
+
+func CfdVerifyConfidentialTxSignature(arg1 uintptr, arg2 string, arg3 string, arg4 string, arg5 string, arg6 string, arg7 Uint32_t, arg8 int, arg9 bool, arg10 Int64_t, arg11 string, arg12 int) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7.Swigcptr()
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10.Swigcptr()
+	_swig_i_10 := arg11
+	_swig_i_11 := arg12
+	swig_r = (int)(C._wrap_CfdVerifyConfidentialTxSignature_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_89)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_90)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_91)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_92)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_93)(unsafe.Pointer(&_swig_i_5)), C.uintptr_t(_swig_i_6), C.swig_intgo(_swig_i_7), C._Bool(_swig_i_8), C.uintptr_t(_swig_i_9), *(*C.swig_type_94)(unsafe.Pointer(&_swig_i_10)), C.swig_intgo(_swig_i_11)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg11
+	}
+	return swig_r
+}
+
+type Enum_SS_CfdExtKeyType int
+func _swig_getkCfdExtPrivkey() (_swig_ret Enum_SS_CfdExtKeyType) {
+	var swig_r Enum_SS_CfdExtKeyType
+	swig_r = (Enum_SS_CfdExtKeyType)(C._wrap_kCfdExtPrivkey_cfdgo_a23e02774b82509b())
+	return swig_r
+}
+
+var KCfdExtPrivkey Enum_SS_CfdExtKeyType = _swig_getkCfdExtPrivkey()
+func _swig_getkCfdExtPubkey() (_swig_ret Enum_SS_CfdExtKeyType) {
+	var swig_r Enum_SS_CfdExtKeyType
+	swig_r = (Enum_SS_CfdExtKeyType)(C._wrap_kCfdExtPubkey_cfdgo_a23e02774b82509b())
+	return swig_r
 }
 
 var KCfdExtPubkey Enum_SS_CfdExtKeyType = _swig_getkCfdExtPubkey()
@@ -1362,7 +1865,7 @@ func CfdCalculateEcSignature(arg1 uintptr, arg2 string, arg3 string, arg4 string
 	_swig_i_4 := arg5
 	_swig_i_5 := arg6
 	_swig_i_6 := arg7
-	swig_r = (int)(C._wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_67)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_68)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_69)(unsafe.Pointer(&_swig_i_3)), C.swig_intgo(_swig_i_4), C._Bool(_swig_i_5), C.swig_voidp(_swig_i_6)))
+	swig_r = (int)(C._wrap_CfdCalculateEcSignature_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_95)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_96)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_97)(unsafe.Pointer(&_swig_i_3)), C.swig_intgo(_swig_i_4), C._Bool(_swig_i_5), C.swig_voidp(_swig_i_6)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1375,71 +1878,138 @@ func CfdCalculateEcSignature(arg1 uintptr, arg2 string, arg3 string, arg4 string
 	return swig_r
 }
 
-func CfdCreateKeyPair(arg1 uintptr, arg2 bool, arg3 int, arg4 *string, arg5 *string, arg6 *string) (_swig_ret int) {
+func CfdVerifyEcSignature(arg1 uintptr, arg2 string, arg3 string, arg4 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdVerifyEcSignature_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_98)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_99)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_100)(unsafe.Pointer(&_swig_i_3))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	return swig_r
+}
+
+func CfdEncodeSignatureByDer(arg1 uintptr, arg2 string, arg3 int, arg4 bool, arg5 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
-	_swig_i_5 := arg6
-	swig_r = (int)(C._wrap_CfdCreateKeyPair_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C._Bool(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.swig_voidp(_swig_i_5)))
+	swig_r = (int)(C._wrap_CfdEncodeSignatureByDer_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_101)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C._Bool(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
 	return swig_r
 }
 
-func CfdGetPrivkeyFromWif(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+func CfdDecodeSignatureFromDer(arg1 uintptr, arg2 string, arg3 *string, arg4 *int, arg5 *bool) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_70)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	_swig_i_4 := arg5
+	swig_r = (int)(C._wrap_CfdDecodeSignatureFromDer_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_102)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
 	return swig_r
 }
 
-func CfdGetPubkeyFromPrivkey(arg1 uintptr, arg2 string, arg3 string, arg4 bool, arg5 *string) (_swig_ret int) {
+func CfdNormalizeSignature(arg1 uintptr, arg2 string, arg3 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	swig_r = (int)(C._wrap_CfdNormalizeSignature_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_103)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdSignSchnorr(arg1 uintptr, arg2 string, arg3 string, arg4 string, arg5 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_71)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_72)(unsafe.Pointer(&_swig_i_2)), C._Bool(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdSignSchnorr_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_104)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_105)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_106)(unsafe.Pointer(&_swig_i_3)), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
 	return swig_r
 }
 
-func CfdCreateExtkeyFromSeed(arg1 uintptr, arg2 string, arg3 int, arg4 int, arg5 *string) (_swig_ret int) {
+func CfdVerifySchnorr(arg1 uintptr, arg2 string, arg3 string, arg4 string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_73)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdVerifySchnorr_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_107)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_108)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_109)(unsafe.Pointer(&_swig_i_3))))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
 	return swig_r
 }
 
-func CfdCreateExtkeyFromParentPath(arg1 uintptr, arg2 string, arg3 string, arg4 int, arg5 int, arg6 *string) (_swig_ret int) {
+func CfdGetSchnorrPubkeyFromPrivkey(arg1 uintptr, arg2 string, arg3 *string, arg4 *bool) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetSchnorrPubkeyFromPrivkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_110)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetSchnorrPubkeyFromPubkey(arg1 uintptr, arg2 string, arg3 *string, arg4 *bool) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetSchnorrPubkeyFromPubkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_111)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdSchnorrPubkeyTweakAdd(arg1 uintptr, arg2 string, arg3 string, arg4 *string, arg5 *bool) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
-	_swig_i_5 := arg6
-	swig_r = (int)(C._wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_74)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_75)(unsafe.Pointer(&_swig_i_2)), C.swig_intgo(_swig_i_3), C.swig_intgo(_swig_i_4), C.swig_voidp(_swig_i_5)))
+	swig_r = (int)(C._wrap_CfdSchnorrPubkeyTweakAdd_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_112)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_113)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
@@ -1449,102 +2019,143 @@ func CfdCreateExtkeyFromParentPath(arg1 uintptr, arg2 string, arg3 string, arg4
 	return swig_r
 }
 
-func CfdCreateExtPubkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+func CfdSchnorrKeyPairTweakAdd(arg1 uintptr, arg2 string, arg3 string, arg4 *string, arg5 *bool, arg6 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_76)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdSchnorrKeyPairTweakAdd_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_114)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_115)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.swig_voidp(_swig_i_5)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
 	return swig_r
 }
 
-func CfdGetPrivkeyFromExtkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string, arg5 *string) (_swig_ret int) {
+func CfdCheckTweakAddFromSchnorrPubkey(arg1 uintptr, arg2 string, arg3 bool, arg4 string, arg5 string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
-	swig_r = (int)(C._wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_77)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	swig_r = (int)(C._wrap_CfdCheckTweakAddFromSchnorrPubkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_116)(unsafe.Pointer(&_swig_i_1)), C._Bool(_swig_i_2), *(*C.swig_type_117)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_118)(unsafe.Pointer(&_swig_i_4))))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
 	return swig_r
 }
 
-func CfdGetPubkeyFromExtkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+func CfdPubkeyTweakAdd(arg1 uintptr, arg2 string, arg3 string, arg4 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_78)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	swig_r = (int)(C._wrap_CfdPubkeyTweakAdd_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_119)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_120)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
 	return swig_r
 }
 
-func CfdParseScript(arg1 uintptr, arg2 string, arg3 *uintptr, arg4 Uint32_t) (_swig_ret int) {
+func CfdCompressPubkey(arg1 uintptr, arg2 string, arg3 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
-	_swig_i_3 := arg4.Swigcptr()
-	swig_r = (int)(C._wrap_CfdParseScript_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_79)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.uintptr_t(_swig_i_3)))
+	swig_r = (int)(C._wrap_CfdCompressPubkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_121)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2)))
 	if Swig_escape_always_false {
 		Swig_escape_val = arg2
 	}
 	return swig_r
 }
 
-func CfdGetScriptItem(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string) (_swig_ret int) {
+func CfdCreateKeyPair(arg1 uintptr, arg2 bool, arg3 int, arg4 *string, arg5 *string, arg6 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
-	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdGetScriptItem_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdCreateKeyPair_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C._Bool(_swig_i_1), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.swig_voidp(_swig_i_5)))
 	return swig_r
 }
 
-func CfdFreeScriptItemHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+func CfdGetPrivkeyFromWif(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
-	swig_r = (int)(C._wrap_CfdFreeScriptItemHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetPrivkeyFromWif_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_122)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
 	return swig_r
 }
 
-func CfdInitializeMultisigSign(arg1 uintptr, arg2 *uintptr) (_swig_ret int) {
+func CfdParsePrivkeyWif(arg1 uintptr, arg2 string, arg3 *string, arg4 *int, arg5 *bool) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
-	swig_r = (int)(C._wrap_CfdInitializeMultisigSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_voidp(_swig_i_1)))
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	swig_r = (int)(C._wrap_CfdParsePrivkeyWif_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_123)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
 	return swig_r
 }
 
-func CfdAddMultisigSignData(arg1 uintptr, arg2 uintptr, arg3 string, arg4 string) (_swig_ret int) {
+func CfdGetPubkeyFromPrivkey(arg1 uintptr, arg2 string, arg3 string, arg4 bool, arg5 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
 	_swig_i_2 := arg3
 	_swig_i_3 := arg4
-	swig_r = (int)(C._wrap_CfdAddMultisigSignData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_80)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_81)(unsafe.Pointer(&_swig_i_3))))
+	_swig_i_4 := arg5
+	swig_r = (int)(C._wrap_CfdGetPubkeyFromPrivkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_124)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_125)(unsafe.Pointer(&_swig_i_2)), C._Bool(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
+	return swig_r
+}
+
+func CfdCreateExtkeyFromSeed(arg1 uintptr, arg2 string, arg3 int, arg4 int, arg5 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	swig_r = (int)(C._wrap_CfdCreateExtkeyFromSeed_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_126)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_intgo(_swig_i_3), C.swig_voidp(_swig_i_4)))
 	if Swig_escape_always_false {
-		Swig_escape_val = arg4
+		Swig_escape_val = arg2
 	}
 	return swig_r
 }
 
-func CfdAddMultisigSignDataToDer(arg1 uintptr, arg2 uintptr, arg3 string, arg4 int, arg5 bool, arg6 string) (_swig_ret int) {
+func CfdCreateExtkeyFromParentPath(arg1 uintptr, arg2 string, arg3 string, arg4 int, arg5 int, arg6 *string) (_swig_ret int) {
 	var swig_r int
 	_swig_i_0 := arg1
 	_swig_i_1 := arg2
@@ -1552,79 +2163,639 @@ func CfdAddMultisigSignDataToDer(arg1 uintptr, arg2 uintptr, arg3 string, arg4 i
 	_swig_i_3 := arg4
 	_swig_i_4 := arg5
 	_swig_i_5 := arg6
-	swig_r = (int)(C._wrap_CfdAddMultisigSignDataToDer_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_82)(unsafe.Pointer(&_swig_i_2)), C.swig_intgo(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_83)(unsafe.Pointer(&_swig_i_5))))
+	swig_r = (int)(C._wrap_CfdCreateExtkeyFromParentPath_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_127)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_128)(unsafe.Pointer(&_swig_i_2)), C.swig_intgo(_swig_i_3), C.swig_intgo(_swig_i_4), C.swig_voidp(_swig_i_5)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
 	if Swig_escape_always_false {
 		Swig_escape_val = arg3
 	}
-	if Swig_escape_always_false {
-		Swig_escape_val = arg6
+	return swig_r
+}
+
+func CfdCreateExtPubkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdCreateExtPubkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_129)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetPrivkeyFromExtkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string, arg5 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	swig_r = (int)(C._wrap_CfdGetPrivkeyFromExtkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_130)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetPubkeyFromExtkey(arg1 uintptr, arg2 string, arg3 int, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetPubkeyFromExtkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_131)(unsafe.Pointer(&_swig_i_1)), C.swig_intgo(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdCreateExtkey(arg1 uintptr, arg2 int, arg3 int, arg4 string, arg5 string, arg6 string, arg7 string, arg8 byte, arg9 Uint32_t, arg10 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9.Swigcptr()
+	_swig_i_9 := arg10
+	swig_r = (int)(C._wrap_CfdCreateExtkey_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.swig_intgo(_swig_i_2), *(*C.swig_type_132)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_133)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_134)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_135)(unsafe.Pointer(&_swig_i_6)), C.char(_swig_i_7), C.uintptr_t(_swig_i_8), C.swig_voidp(_swig_i_9)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	return swig_r
+}
+
+func CfdGetExtkeyInformation(arg1 uintptr, arg2 string, arg3 *string, arg4 *string, arg5 *string, arg6 Uint32_t, arg7 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7.Swigcptr()
+	swig_r = (int)(C._wrap_CfdGetExtkeyInformation_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_136)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.swig_voidp(_swig_i_3), C.swig_voidp(_swig_i_4), C.uintptr_t(_swig_i_5), C.uintptr_t(_swig_i_6)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdInitializeMnemonicWordList(arg1 uintptr, arg2 string, arg3 *uintptr, arg4 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	swig_r = (int)(C._wrap_CfdInitializeMnemonicWordList_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_137)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.uintptr_t(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetMnemonicWord(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetMnemonicWord_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	return swig_r
+}
+
+func CfdFreeMnemonicWordList(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdFreeMnemonicWordList_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+func CfdConvertMnemonicToSeed(arg1 uintptr, arg2 string, arg3 string, arg4 bool, arg5 string, arg6 bool, arg7 *string, arg8 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	swig_r = (int)(C._wrap_CfdConvertMnemonicToSeed_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_138)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_139)(unsafe.Pointer(&_swig_i_2)), C._Bool(_swig_i_3), *(*C.swig_type_140)(unsafe.Pointer(&_swig_i_4)), C._Bool(_swig_i_5), C.swig_voidp(_swig_i_6), C.swig_voidp(_swig_i_7)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	return swig_r
+}
+
+func CfdConvertEntropyToMnemonic(arg1 uintptr, arg2 string, arg3 string, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdConvertEntropyToMnemonic_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_141)(unsafe.Pointer(&_swig_i_1)), *(*C.swig_type_142)(unsafe.Pointer(&_swig_i_2)), C.swig_voidp(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
+func CfdParseScript(arg1 uintptr, arg2 string, arg3 *uintptr, arg4 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	swig_r = (int)(C._wrap_CfdParseScript_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), *(*C.swig_type_143)(unsafe.Pointer(&_swig_i_1)), C.swig_voidp(_swig_i_2), C.uintptr_t(_swig_i_3)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg2
+	}
+	return swig_r
+}
+
+func CfdGetScriptItem(arg1 uintptr, arg2 uintptr, arg3 Uint32_t, arg4 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdGetScriptItem_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), C.swig_voidp(_swig_i_3)))
+	return swig_r
+}
+
+func CfdFreeScriptItemHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdFreeScriptItemHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+func CfdInitializeTransaction(arg1 uintptr, arg2 int, arg3 Uint32_t, arg4 Uint32_t, arg5 string, arg6 *uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdInitializeTransaction_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), C.uintptr_t(_swig_i_2), C.uintptr_t(_swig_i_3), *(*C.swig_type_144)(unsafe.Pointer(&_swig_i_4)), C.swig_voidp(_swig_i_5)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	return swig_r
+}
+
+func CfdAddTransactionInput(arg1 uintptr, arg2 uintptr, arg3 string, arg4 Uint32_t, arg5 Uint32_t) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5.Swigcptr()
+	swig_r = (int)(C._wrap_CfdAddTransactionInput_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_145)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	return swig_r
+}
+
+func CfdAddTransactionOutput(arg1 uintptr, arg2 uintptr, arg3 Int64_t, arg4 string, arg5 string, arg6 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3.Swigcptr()
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdAddTransactionOutput_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.uintptr_t(_swig_i_2), *(*C.swig_type_146)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_147)(unsafe.Pointer(&_swig_i_4)), *(*C.swig_type_148)(unsafe.Pointer(&_swig_i_5))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	return swig_r
+}
+
+func CfdAddTxPeginInput(arg1 uintptr, arg2 uintptr, arg3 string, arg4 Uint32_t, arg5 Int64_t, arg6 string, arg7 string, arg8 string, arg9 string, arg10 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	swig_r = (int)(C._wrap_CfdAddTxPeginInput_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_149)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.uintptr_t(_swig_i_4), *(*C.swig_type_150)(unsafe.Pointer(&_swig_i_5)), *(*C.swig_type_151)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_152)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_153)(unsafe.Pointer(&_swig_i_8)), *(*C.swig_type_154)(unsafe.Pointer(&_swig_i_9))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg8
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg9
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg10
+	}
+	return swig_r
+}
+
+func CfdAddTxPegoutOutput(arg1 uintptr, arg2 uintptr, arg3 string, arg4 Int64_t, arg5 int, arg6 int, arg7 string, arg8 string, arg9 string, arg10 string, arg11 Uint32_t, arg12 string, arg13 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4.Swigcptr()
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11.Swigcptr()
+	_swig_i_11 := arg12
+	_swig_i_12 := arg13
+	swig_r = (int)(C._wrap_CfdAddTxPegoutOutput_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_155)(unsafe.Pointer(&_swig_i_2)), C.uintptr_t(_swig_i_3), C.swig_intgo(_swig_i_4), C.swig_intgo(_swig_i_5), *(*C.swig_type_156)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_157)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_158)(unsafe.Pointer(&_swig_i_8)), *(*C.swig_type_159)(unsafe.Pointer(&_swig_i_9)), C.uintptr_t(_swig_i_10), *(*C.swig_type_160)(unsafe.Pointer(&_swig_i_11)), C.swig_voidp(_swig_i_12)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg8
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg9
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg10
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg12
+	}
+	return swig_r
+}
+
+func CfdFinalizeTransaction(arg1 uintptr, arg2 uintptr, arg3 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	swig_r = (int)(C._wrap_CfdFinalizeTransaction_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_voidp(_swig_i_2)))
+	return swig_r
+}
+
+func CfdFreeTransactionHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdFreeTransactionHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+func CfdCreateSighash(arg1 uintptr, arg2 int, arg3 string, arg4 string, arg5 Uint32_t, arg6 int, arg7 string, arg8 string, arg9 Int64_t, arg10 int, arg11 bool, arg12 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9.Swigcptr()
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11
+	_swig_i_11 := arg12
+	swig_r = (int)(C._wrap_CfdCreateSighash_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), *(*C.swig_type_161)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_162)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), C.swig_intgo(_swig_i_5), *(*C.swig_type_163)(unsafe.Pointer(&_swig_i_6)), *(*C.swig_type_164)(unsafe.Pointer(&_swig_i_7)), C.uintptr_t(_swig_i_8), C.swig_intgo(_swig_i_9), C._Bool(_swig_i_10), C.swig_voidp(_swig_i_11)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg8
+	}
+	return swig_r
+}
+
+func CfdAddTxSign(arg1 uintptr, arg2 int, arg3 string, arg4 string, arg5 Uint32_t, arg6 int, arg7 string, arg8 bool, arg9 int, arg10 bool, arg11 bool, arg12 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5.Swigcptr()
+	_swig_i_5 := arg6
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11
+	_swig_i_11 := arg12
+	swig_r = (int)(C._wrap_CfdAddTxSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_intgo(_swig_i_1), *(*C.swig_type_165)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_166)(unsafe.Pointer(&_swig_i_3)), C.uintptr_t(_swig_i_4), C.swig_intgo(_swig_i_5), *(*C.swig_type_167)(unsafe.Pointer(&_swig_i_6)), C._Bool(_swig_i_7), C.swig_intgo(_swig_i_8), C._Bool(_swig_i_9), C._Bool(_swig_i_10), C.swig_voidp(_swig_i_11)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg7
+	}
+	return swig_r
+}
+
+func CfdInitializeMultisigSign(arg1 uintptr, arg2 *uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdInitializeMultisigSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.swig_voidp(_swig_i_1)))
+	return swig_r
+}
+
+func CfdAddMultisigSignData(arg1 uintptr, arg2 uintptr, arg3 string, arg4 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	swig_r = (int)(C._wrap_CfdAddMultisigSignData_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_168)(unsafe.Pointer(&_swig_i_2)), *(*C.swig_type_169)(unsafe.Pointer(&_swig_i_3))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	return swig_r
+}
+
+func CfdAddMultisigSignDataToDer(arg1 uintptr, arg2 uintptr, arg3 string, arg4 int, arg5 bool, arg6 string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6
+	swig_r = (int)(C._wrap_CfdAddMultisigSignDataToDer_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), *(*C.swig_type_170)(unsafe.Pointer(&_swig_i_2)), C.swig_intgo(_swig_i_3), C._Bool(_swig_i_4), *(*C.swig_type_171)(unsafe.Pointer(&_swig_i_5))))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg3
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg6
+	}
+	return swig_r
+}
+
+func CfdFinalizeMultisigSign(arg1 uintptr, arg2 uintptr, arg3 int, arg4 string, arg5 string, arg6 Uint32_t, arg7 int, arg8 string, arg9 string, arg10 bool, arg11 *string) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	_swig_i_2 := arg3
+	_swig_i_3 := arg4
+	_swig_i_4 := arg5
+	_swig_i_5 := arg6.Swigcptr()
+	_swig_i_6 := arg7
+	_swig_i_7 := arg8
+	_swig_i_8 := arg9
+	_swig_i_9 := arg10
+	_swig_i_10 := arg11
+	swig_r = (int)(C._wrap_CfdFinalizeMultisigSign_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1), C.swig_intgo(_swig_i_2), *(*C.swig_type_172)(unsafe.Pointer(&_swig_i_3)), *(*C.swig_type_173)(unsafe.Pointer(&_swig_i_4)), C.uintptr_t(_swig_i_5), C.swig_intgo(_swig_i_6), *(*C.swig_type_174)(unsafe.Pointer(&_swig_i_7)), *(*C.swig_type_175)(unsafe.Pointer(&_swig_i_8)), C._Bool(_swig_i_9), C.swig_voidp(_swig_i_10)))
+	if Swig_escape_always_false {
+		Swig_escape_val = arg4
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg5
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg8
+	}
+	if Swig_escape_always_false {
+		Swig_escape_val = arg9
+	}
+	return swig_r
+}
+
+func CfdFreeMultisigSignHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
+	var swig_r int
+	_swig_i_0 := arg1
+	_swig_i_1 := arg2
+	swig_r = (int)(C._wrap_CfdFreeMultisigSignHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
+	return swig_r
+}
+
+
+/**
+ * Cfd error struct.
+ * detail: Code is the cfd error code, Message is the last error message
+ *         of the handle (or a fixed message by the error code), and
+ *         Operation is the name of the failed api.
+ *         Use errors.Is with the Err* values to check the error code,
+ *         and errors.Is/errors.As to check the cause error of golang api.
+ */
+type CfdError struct {
+	Code      Enum_SS_CfdErrorCode
+	Message   string
+	Operation string
+	cause     error
+}
+
+/**
+ * Error sentinel values. (compare by errors.Is)
+ */
+var (
+	ErrUnknown          = &CfdError{Code: KCfdUnknownError}
+	ErrInternal         = &CfdError{Code: KCfdInternalError}
+	ErrMemoryFull       = &CfdError{Code: KCfdMemoryFullError}
+	ErrIllegalArgument  = &CfdError{Code: KCfdIllegalArgumentError}
+	ErrIllegalState     = &CfdError{Code: KCfdIllegalStateError}
+	ErrOutOfRange       = &CfdError{Code: KCfdOutOfRangeError}
+	ErrInvalidSetting   = &CfdError{Code: KCfdInvalidSettingError}
+	ErrConnection       = &CfdError{Code: KCfdConnectionError}
+	ErrDiskAccess       = &CfdError{Code: KCfdDiskAccessError}
+	ErrSignVerification = &CfdError{Code: KCfdSignVerificationError}
+)
+
+/**
+ * Get error string.
+ * return: message     error message
+ */
+func (e *CfdError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = getCfdErrorCodeMessage(e.Code)
+	}
+	if e.Operation == "" {
+		return fmt.Sprintf("CFD Error: message=[%s], code=[%d]", message, e.Code)
 	}
-	return swig_r
+	return fmt.Sprintf("CFD Error: message=[%s], code=[%d], operation=[%s]", message, e.Code, e.Operation)
 }
 
-func CfdFreeMultisigSignHandle(arg1 uintptr, arg2 uintptr) (_swig_ret int) {
-	var swig_r int
-	_swig_i_0 := arg1
-	_swig_i_1 := arg2
-	swig_r = (int)(C._wrap_CfdFreeMultisigSignHandle_cfdgo_a23e02774b82509b(C.uintptr_t(_swig_i_0), C.uintptr_t(_swig_i_1)))
-	return swig_r
+/**
+ * Compare error.
+ * detail: CfdError matches a sentinel value (Err*) with the same code.
+ * param: target       compare target
+ * return: result      match result
+ */
+func (e *CfdError) Is(target error) bool {
+	t, ok := target.(*CfdError)
+	if !ok {
+		return false
+	}
+	if t.Message == "" && t.Operation == "" {
+		return t.Code == e.Code
+	}
+	return t.Code == e.Code && t.Message == e.Message && t.Operation == e.Operation
 }
 
+/**
+ * Get cause error.
+ * return: cause       cause error (nil if not set)
+ */
+func (e *CfdError) Unwrap() error {
+	return e.cause
+}
 
 /**
- * Convert return code to golang built-in error struct.
- * param: retCode   return code from cfd
- * return: err      built-in error struct.
- */
-func convertCfdErrorCode(retCode int) (err error) {
-	switch retCode {
-		case (int)(KCfdSuccess):
-			return nil
-		case (int)(KCfdUnknownError):
-			err = fmt.Errorf("CFD Error: Unknown error occered.: errorCode=[%d]", retCode)
-		case (int)(KCfdInternalError):
-			err = fmt.Errorf("CFD Error: Internal error occered.: errorCode=[%d]", retCode)
-		case (int)(KCfdMemoryFullError):
-			err = fmt.Errorf("CFD Error: Memory is full.: errorCode=[%d]", retCode)
-		case (int)(KCfdIllegalArgumentError):
-			err = fmt.Errorf("CFD Error: Illegal argument passed.: errorCode=[%d]", retCode)
-		case (int)(KCfdIllegalStateError):
-			err = fmt.Errorf("CFD Error: Illegal state api call.: errorCode=[%d]", retCode)
-		case (int)(KCfdOutOfRangeError):
-			err = fmt.Errorf("CFD Error: Out of range access occered.: errorCode=[%d]", retCode)
-		case (int)(KCfdInvalidSettingError):
-			err = fmt.Errorf("CFD Error: Invalid setting api call.: errorCode=[%d]", retCode)
-		case (int)(KCfdConnectionError):
-			err = fmt.Errorf("CFD Error: Connection error occered.: errorCode=[%d]", retCode)
-		case (int)(KCfdDiskAccessError):
-			err = fmt.Errorf("CFD Error: Disk access error occered.: errorCode=[%d]", retCode)
+ * Get fixed message by error code.
+ * param: code         cfd error code
+ * return: message     fixed message
+ */
+func getCfdErrorCodeMessage(code Enum_SS_CfdErrorCode) (message string) {
+	switch code {
+		case KCfdSuccess:
+			message = "Success."
+		case KCfdUnknownError:
+			message = "Unknown error occered."
+		case KCfdInternalError:
+			message = "Internal error occered."
+		case KCfdMemoryFullError:
+			message = "Memory is full."
+		case KCfdIllegalArgumentError:
+			message = "Illegal argument passed."
+		case KCfdIllegalStateError:
+			message = "Illegal state api call."
+		case KCfdOutOfRangeError:
+			message = "Out of range access occered."
+		case KCfdInvalidSettingError:
+			message = "Invalid setting api call."
+		case KCfdConnectionError:
+			message = "Connection error occered."
+		case KCfdDiskAccessError:
+			message = "Disk access error occered."
+		case KCfdSignVerificationError:
+			message = "Signature verification failed."
+		default:
+			message = "Unknown error code."
 	}
 	return
 }
 
 /**
- * Convert handle and error code to Golang built-in error.
- * detail: if handle is nil, return fixed message by the error code.
- * param: retCode   cfd return code.
- * param: handle    cfd handle.
- * return: err      built-in error struct.
+ * Convert handle and error code to CfdError.
+ * detail: if handle is nil, set fixed message by the error code.
+ * param: retCode     cfd return code.
+ * param: handle      cfd handle.
+ * param: operation   failed api name.
+ * return: err        CfdError struct. (nil if success)
  */
-func convertCfdError(retCode int, handle uintptr) (err error) {
+func convertCfdError(retCode int, handle uintptr, operation string) (err error) {
 	if retCode == (int)(KCfdSuccess) {
 		return
 	}
 
 	var errorMsg string
-	if handle ==  uintptr(0) {
-		err = convertCfdErrorCode(retCode)
-	} else if ret := CfdGetLastErrorMessage(handle, &errorMsg); ret != (int)(KCfdSuccess) {
-		err = convertCfdErrorCode(retCode)
-	} else {
-		err = fmt.Errorf("CFD Error: messaga=[%s], code=[%d]", errorMsg, retCode)
+	if handle != uintptr(0) {
+		if ret := CfdGetLastErrorMessage(handle, &errorMsg); ret != (int)(KCfdSuccess) {
+			errorMsg = ""
+		}
+	}
+	if errorMsg == "" {
+		errorMsg = getCfdErrorCodeMessage(Enum_SS_CfdErrorCode(retCode))
+	}
+	err = &CfdError{
+		Code:      Enum_SS_CfdErrorCode(retCode),
+		Message:   errorMsg,
+		Operation: operation,
 	}
 	return
 }
 
+/**
+ * Convert golang api error to CfdError.
+ * detail: if err is CfdError without operation, set operation.
+ *         other error is converted to illegal argument error,
+ *         and it is kept as the cause error.
+ * param: err         error struct.
+ * param: operation   failed api name.
+ * return: cfdErr     CfdError struct. (nil if err is nil)
+ */
+func convertGoError(err error, operation string) (cfdErr error) {
+	if err == nil {
+		return nil
+	}
+	if e, ok := err.(*CfdError); ok {
+		if e.Operation != "" {
+			return e
+		}
+		return &CfdError{Code: e.Code, Message: e.Message, Operation: operation, cause: e.cause}
+	}
+	return &CfdError{
+		Code:      KCfdIllegalArgumentError,
+		Message:   err.Error(),
+		Operation: operation,
+		cause:     err,
+	}
+}
+
 /**
  * Get supported function.
  * return: funcFlag    function flag.
@@ -1633,7 +2804,7 @@ func convertCfdError(retCode int, handle uintptr) (err error) {
 func CfdGoGetSupportedFunction() (funcFlag uint64, err error) {
 	funcFlagValue := SwigcptrUint64_t(uintptr(unsafe.Pointer(&funcFlag)))
 	ret := CfdGetSupportedFunction(funcFlagValue)
-	err = convertCfdError(ret, uintptr(0))
+	err = convertCfdError(ret, uintptr(0), "CfdGoGetSupportedFunction")
 	return funcFlag, err
 }
 
@@ -1644,7 +2815,7 @@ func CfdGoGetSupportedFunction() (funcFlag uint64, err error) {
  */
 func CfdGoCreateHandle() (handle uintptr, err error) {
 	ret := CfdCreateHandle(&handle)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoCreateHandle")
 	return handle, err
 }
 
@@ -1655,7 +2826,7 @@ func CfdGoCreateHandle() (handle uintptr, err error) {
  */
 func CfdGoFreeHandle(handle uintptr) (err error) {
 	ret := CfdFreeHandle(handle)
-	err = convertCfdError(ret, uintptr(0))
+	err = convertCfdError(ret, uintptr(0), "CfdGoFreeHandle")
 	return
 }
 
@@ -1668,28 +2839,99 @@ func CfdGoFreeHandle(handle uintptr) (err error) {
 func CfdGoGetLastErrorMessage(handle uintptr) (message string, err error) {
 	ret := CfdGetLastErrorMessage(handle, &message)
 	// Do not use the Free API as it will be released by Go-GC.
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoGetLastErrorMessage")
 	return message, err
 }
 
 /**
  * Create Address.
  * param: handle        cfd handle
- * param: hashType      hash type (p2pkh, p2sh, etc...)
- * param: pubkey        pubkey (pubkey hash only)
+ * param: hashType      hash type (p2pkh, p2sh, taproot, etc...)
+ * param: pubkey        pubkey (pubkey hash only. taproot is x-only pubkey)
  * param: redeemScript  redeem script (script hash only)
- * param: networkType   network type
+ * param: networkType   network type (customchain supports base58 address only)
  * return: address                  address string
  * return: lockingScript            locking script
  * return: p2shSegwitLockingScript  p2sh-segwit witness program
  * return: err                      error
  */
-func CfdGoCreateAddress(handle uintptr, hashType int, pubkey string, redeemScript string, networkType int) (address string, lockingScript string, p2shSegwitLockingScript string, err error) {
-	ret := CfdCreateAddress(handle, hashType, pubkey, redeemScript, networkType, &address, &lockingScript, &p2shSegwitLockingScript)
-	err = convertCfdError(ret, handle)
+func CfdGoCreateAddress(handle uintptr, hashType HashType, pubkey string, redeemScript string, networkType NetworkType) (address string, lockingScript string, p2shSegwitLockingScript string, err error) {
+	if hashType == KCfdTaproot {
+		err = validateEnumTypes(networkType)
+	} else {
+		err = validateEnumTypes(networkType, hashType)
+	}
+	if err != nil {
+		err = convertGoError(err, "CfdGoCreateAddress")
+		return
+	}
+	ret := CfdCreateAddress(handle, int(hashType), pubkey, redeemScript, int(getNativeNetworkType(networkType)), &address, &lockingScript, &p2shSegwitLockingScript)
+	err = convertCfdError(ret, handle, "CfdGoCreateAddress")
+	if err == nil && isCustomNetwork(networkType) {
+		if address, err = toCustomNetworkAddress(address); err != nil {
+			err = convertGoError(err, "CfdGoCreateAddress")
+		}
+	}
 	return address, lockingScript, p2shSegwitLockingScript, err
 }
 
+/**
+ * Get address information.
+ * detail: base58 address of testnet and regtest is decoded as testnet.
+ * param: handle       cfd handle
+ * param: address      address string (bitcoin, elements or confidential address)
+ * return: data        address data
+ * return: err         error
+ */
+func CfdGoGetAddressInfo(handle uintptr, address string) (data CfdAddressData, err error) {
+	data.Address = address
+	target := address
+	if unconfidentialAddress, confidentialKey, _, parseErr := CfdGoParseConfidentialAddress(handle, address); parseErr == nil {
+		data.UnconfidentialAddress = unconfidentialAddress
+		data.ConfidentialKey = confidentialKey
+		target = unconfidentialAddress
+	}
+	nativeAddress, isCustom := toNativeNetworkAddress(target)
+	if !isCustom {
+		nativeAddress = target
+	}
+	var networkType, hashType, witnessVersion int
+	ret := CfdGetAddressInfo(handle, nativeAddress, &networkType, &hashType, &witnessVersion, &data.LockingScript, &data.Hash)
+	if err = convertCfdError(ret, handle, "CfdGoGetAddressInfo"); err != nil {
+		return CfdAddressData{}, err
+	}
+	data.NetworkType = NetworkType(networkType)
+	if isCustom {
+		data.NetworkType = KCfdNetworkCustomChain
+	}
+	data.HashType = HashType(hashType)
+	data.WitnessVersion = witnessVersion
+	return data, nil
+}
+
+/**
+ * Get address from locking script.
+ * param: handle         cfd handle
+ * param: lockingScript  locking script (p2pkh, p2sh, p2wpkh, p2wsh, taproot, etc...)
+ * param: networkType    network type (customchain supports base58 address only)
+ * return: address       address string
+ * return: err           error
+ */
+func CfdGoGetAddressFromLockingScript(handle uintptr, lockingScript string, networkType NetworkType) (address string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoGetAddressFromLockingScript")
+		return
+	}
+	ret := CfdGetAddressFromLockingScript(handle, lockingScript, int(getNativeNetworkType(networkType)), &address)
+	err = convertCfdError(ret, handle, "CfdGoGetAddressFromLockingScript")
+	if err == nil && isCustomNetwork(networkType) {
+		if address, err = toCustomNetworkAddress(address); err != nil {
+			err = convertGoError(err, "CfdGoGetAddressFromLockingScript")
+		}
+	}
+	return address, err
+}
+
 /**
  * Create multisig script and address.
  * param: handle        cfd handle
@@ -1702,9 +2944,13 @@ func CfdGoCreateAddress(handle uintptr, hashType int, pubkey string, redeemScrip
  * return: witnessScript  witness script
  * return: err            error
  */
-func CfdGoCreateMultisigScript(handle uintptr, networkType int, hashType int, pubkeys []string, requireNum uint32) (address string, redeemScript string, witnessScript string, err error) {
+func CfdGoCreateMultisigScript(handle uintptr, networkType NetworkType, hashType HashType, pubkeys []string, requireNum uint32) (address string, redeemScript string, witnessScript string, err error) {
+	if err = validateEnumTypes(networkType, hashType); err != nil {
+		err = convertGoError(err, "CfdGoCreateMultisigScript")
+		return
+	}
 	var multisigHandle uintptr
-	ret := CfdInitializeMultisigScript(handle, networkType, hashType, &multisigHandle)
+	ret := CfdInitializeMultisigScript(handle, int(networkType), int(hashType), &multisigHandle)
 	if ret == (int)(KCfdSuccess) {
 		for i := 0; i < len(pubkeys); i++ {
 			ret = CfdAddMultisigScriptData(handle, multisigHandle, pubkeys[i])
@@ -1727,53 +2973,110 @@ func CfdGoCreateMultisigScript(handle uintptr, networkType int, hashType int, pu
 	if ret == (int)(KCfdSuccess) {
 		return address, redeemScript, witnessScript, err
 	} else {
-		err = convertCfdError(ret, handle)
+		err = convertCfdError(ret, handle, "CfdGoCreateMultisigScript")
 		return "", "", "", err
 	}
 }
 
 /**
  * Descriptor data struct.
+ * detail: One script layer of the parsed descriptor. The list starts at
+ *         the outermost script (depth 0).
  */
 type CfdDescriptorData struct {
-	depth uint32
-	scriptType int
-	lockingScript string
-	address string
-	hashType int
-	redeemScript string
-	keyType int
-	pubkey string
-	extPubkey string
-	extPrivkey string
-	isMultisig bool
+	// script depth (0 is the outermost script)
+	Depth uint32
+	// descriptor script type (sh, wsh, pkh, multi, etc...)
+	ScriptType DescriptorScriptType
+	// locking script
+	LockingScript string
+	// address string (empty if the script has no address)
+	Address string
+	// hash type (p2sh, p2wsh, etc...)
+	HashType HashType
+	// redeem script (script hash only)
+	RedeemScript string
+	// key type (null if the script has no key)
+	KeyType DescriptorKeyType
+	// pubkey
+	Pubkey string
+	// extended pubkey (bip32 key only)
+	ExtPubkey string
+	// extended privkey (bip32 private key only)
+	ExtPrivkey string
+	// multisig flag (keys are set to the multisig key list)
+	IsMultisig bool
+	// key origin (empty if the key has no origin)
+	KeyOrigin CfdDescriptorKeyOrigin
 }
 
 /**
  * Descriptor key data struct.
  */
 type CfdDescriptorKeyData struct {
-	keyType int
-	pubkey string
-	extPubkey string
-	extPrivkey string
+	// key type
+	KeyType DescriptorKeyType
+	// pubkey
+	Pubkey string
+	// extended pubkey (bip32 key only)
+	ExtPubkey string
+	// extended privkey (bip32 private key only)
+	ExtPrivkey string
+	// key origin (empty if the key has no origin)
+	KeyOrigin CfdDescriptorKeyOrigin
+}
+
+/**
+ * Get descriptor checksum.
+ * param: handle       cfd handle
+ * param: descriptor   output descriptor (existing checksum is ignored)
+ * param: networkType  network type
+ * return: checksum    checksum
+ * return: err         error
+ */
+func CfdGoGetDescriptorChecksum(handle uintptr, descriptor string, networkType NetworkType) (checksum string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoGetDescriptorChecksum")
+		return
+	}
+	if position := strings.LastIndex(descriptor, "#"); position >= 0 {
+		descriptor = descriptor[:position]
+	}
+	var descriptorAddedChecksum string
+	ret := CfdGetDescriptorChecksum(handle, int(getNativeNetworkType(networkType)), descriptor, &descriptorAddedChecksum)
+	if err = convertCfdError(ret, handle, "CfdGoGetDescriptorChecksum"); err != nil {
+		return "", err
+	}
+	position := strings.LastIndex(descriptorAddedChecksum, "#")
+	if position < 0 {
+		return "", convertGoError(errors.New("Descriptor checksum is not found."), "CfdGoGetDescriptorChecksum")
+	}
+	return descriptorAddedChecksum[position+1:], nil
 }
 
 /**
  * Parse Output Descriptor.
  * param: handle               cfd handle
- * param: descriptor           output descriptor
+ * param: descriptor           output descriptor (checksum is validated if exists)
  * param: networkType          network type
  * param: bip32DerivationPath  derive path
  * return: descriptorDataList  descriptor data struct list
  * return: multisigList        multisig key struct list
  * return: err                 error
  */
-func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType int, bip32DerivationPath string) (descriptorDataList []CfdDescriptorData, multisigList []CfdDescriptorKeyData, err error) {
+func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType NetworkType, bip32DerivationPath string) (descriptorDataList []CfdDescriptorData, multisigList []CfdDescriptorKeyData, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoParseDescriptor")
+		return
+	}
+	if descriptor, _, err = splitDescriptorChecksum(handle, descriptor, networkType); err != nil {
+		err = convertGoError(err, "CfdGoParseDescriptor")
+		return
+	}
 	var descriptorHandle uintptr
 	var maxIndex uint32
 	maxIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&maxIndex)))
-	ret := CfdParseDescriptor(handle, descriptor, networkType, bip32DerivationPath, &descriptorHandle, maxIndexPtr)
+	ret := CfdParseDescriptor(handle, descriptor, int(networkType), bip32DerivationPath, &descriptorHandle, maxIndexPtr)
 	if ret == (int)(KCfdSuccess) {
 		var maxMultisigKeyNum uint32
 		lastMultisigFlag := false
@@ -1782,18 +3085,18 @@ func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType int, bi
 			var data CfdDescriptorData
 			var maxNum uint32
 			maxNumPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&maxNum)))
-			depthPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&(data.depth))))
+			depthPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&(data.Depth))))
 			index := SwigcptrUint32_t(uintptr(unsafe.Pointer(&i)))
 			ret = CfdGetDescriptorData(handle, descriptorHandle, index, maxNumPtr,
-					depthPtr, &data.scriptType, &data.lockingScript,
-					&data.address, &data.hashType, &data.redeemScript,
-					&data.keyType, &data.pubkey, &data.extPubkey, &data.extPrivkey,
-					&data.isMultisig, keyNumPtr)
+					depthPtr, (*int)(&data.ScriptType), &data.LockingScript,
+					&data.Address, (*int)(&data.HashType), &data.RedeemScript,
+					(*int)(&data.KeyType), &data.Pubkey, &data.ExtPubkey, &data.ExtPrivkey,
+					&data.IsMultisig, keyNumPtr)
 			if ret != (int)(KCfdSuccess) {
 				break
 			}
 			descriptorDataList = append(descriptorDataList, data)
-			lastMultisigFlag = data.isMultisig
+			lastMultisigFlag = data.IsMultisig
 		}
 
 		if lastMultisigFlag && (ret == (int)(KCfdSuccess)) {
@@ -1801,8 +3104,8 @@ func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType int, bi
 				var keyData CfdDescriptorKeyData
 				index := SwigcptrUint32_t(uintptr(unsafe.Pointer(&i)))
 				ret = CfdGetDescriptorMultisigKey(handle, descriptorHandle,
-						index, &keyData.keyType, &keyData.pubkey,
-						&keyData.extPubkey, &keyData.extPrivkey)
+						index, (*int)(&keyData.KeyType), &keyData.Pubkey,
+						&keyData.ExtPubkey, &keyData.ExtPrivkey)
 				if ret != (int)(KCfdSuccess) {
 					break
 				}
@@ -1816,13 +3119,73 @@ func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType int, bi
 		}
 	}
 	if ret == (int)(KCfdSuccess) {
+		if err = setDescriptorKeyOrigins(descriptor, descriptorDataList, multisigList); err != nil {
+			err = convertGoError(err, "CfdGoParseDescriptor")
+			return []CfdDescriptorData{}, []CfdDescriptorKeyData{}, err
+		}
 		return descriptorDataList, multisigList, err
 	} else {
-		err = convertCfdError(ret, handle)
+		err = convertCfdError(ret, handle, "CfdGoParseDescriptor")
 		return []CfdDescriptorData{}, []CfdDescriptorKeyData{}, err
 	}
 }
 
+/**
+ * Descriptor range data struct.
+ */
+type CfdDescriptorRangeData struct {
+	// derivation index of the wildcard
+	Index uint32
+	// locking script
+	LockingScript string
+	// address string (empty if the script has no address)
+	Address string
+}
+
+/**
+ * Derive Output Descriptor by the index range.
+ * detail: the descriptor is parsed once, and the wildcard ('*') keys are derived
+ *         by each index from the parsed form. (combo is not supported)
+ * param: handle               cfd handle
+ * param: descriptor           output descriptor (with wildcard)
+ * param: networkType          network type
+ * param: start                start index
+ * param: end                  end index (inclusive)
+ * return: rangeDataList       derived data list (start to end)
+ * return: err                 error
+ */
+func CfdGoDeriveDescriptorRange(handle uintptr, descriptor string, networkType NetworkType, start uint32, end uint32) (rangeDataList []CfdDescriptorRangeData, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoDeriveDescriptorRange")
+		return
+	}
+	if start > end || end >= 0x80000000 {
+		err = convertGoError(errors.New("Invalid derivation index range."), "CfdGoDeriveDescriptorRange")
+		return
+	}
+	if descriptor, _, err = splitDescriptorChecksum(handle, descriptor, networkType); err != nil {
+		err = convertGoError(err, "CfdGoDeriveDescriptorRange")
+		return
+	}
+	descriptorDataList, _, err := CfdGoParseDescriptor(handle, descriptor, networkType, strconv.FormatUint(uint64(start), 10))
+	if err != nil {
+		return []CfdDescriptorRangeData{}, convertGoError(err, "CfdGoDeriveDescriptorRange")
+	}
+	rangeScript, err := parseDescriptorRangeScript(handle, descriptor, networkType, descriptorDataList[0])
+	if err != nil {
+		return []CfdDescriptorRangeData{}, convertGoError(err, "CfdGoDeriveDescriptorRange")
+	}
+	rangeDataList = make([]CfdDescriptorRangeData, 0, end-start+1)
+	for index := uint64(start); index <= uint64(end); index++ {
+		data := CfdDescriptorRangeData{Index: uint32(index)}
+		if data.LockingScript, data.Address, err = rangeScript.derive(handle, uint32(index)); err != nil {
+			return []CfdDescriptorRangeData{}, convertGoError(err, "CfdGoDeriveDescriptorRange")
+		}
+		rangeDataList = append(rangeDataList, data)
+	}
+	return rangeDataList, nil
+}
+
 /**
  * Get multisig pubkeys address.
  * param: handle        cfd handle
@@ -1833,13 +3196,17 @@ func CfdGoParseDescriptor(handle uintptr, descriptor string, networkType int, bi
  * return: pubkeyList   pubkey list
  * return: err          error
  */
-func CfdGoGetAddressesFromMultisig(handle uintptr, redeemScript string, networkType int, hashType int) (addressList []string, pubkeyList []string, err error) {
+func CfdGoGetAddressesFromMultisig(handle uintptr, redeemScript string, networkType NetworkType, hashType HashType) (addressList []string, pubkeyList []string, err error) {
+	if err = validateEnumTypes(networkType, hashType); err != nil {
+		err = convertGoError(err, "CfdGoGetAddressesFromMultisig")
+		return
+	}
 	var multisigHandle uintptr
 	var maxKeyNum uint32
 	maxKeyNumPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&maxKeyNum)))
 
-	ret := CfdGetAddressesFromMultisig(handle, redeemScript, networkType,
-			hashType, &multisigHandle, maxKeyNumPtr)
+	ret := CfdGetAddressesFromMultisig(handle, redeemScript, int(networkType),
+			int(hashType), &multisigHandle, maxKeyNumPtr)
 	if ret == (int)(KCfdSuccess) {
 		for i := uint32(0); i < maxKeyNum; i++ {
 			var pubkey string
@@ -1862,7 +3229,7 @@ func CfdGoGetAddressesFromMultisig(handle uintptr, redeemScript string, networkT
 	if ret == (int)(KCfdSuccess) {
 		return addressList, pubkeyList, err
 	} else {
-		err = convertCfdError(ret, handle)
+		err = convertCfdError(ret, handle, "CfdGoGetAddressesFromMultisig")
 		return []string{}, []string{}, err
 	}
 }
@@ -1879,7 +3246,7 @@ func CfdGoInitializeConfidentialTx(handle uintptr, version uint32, locktime uint
 	versionPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&version)))
 	locktimePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&locktime)))
 	ret := CfdInitializeConfidentialTx(handle, versionPtr, locktimePtr, &txHex)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoInitializeConfidentialTx")
 	return txHex, err
 }
 
@@ -1897,7 +3264,7 @@ func CfdGoAddConfidentialTxIn(handle uintptr, txHex string, txid string, vout ui
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	sequencePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&sequence)))
 	ret := CfdAddConfidentialTxIn(handle, txHex, txid, voutPtr, sequencePtr, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoAddConfidentialTxIn")
 	return outputTxHex, err
 }
 
@@ -1914,39 +3281,267 @@ func CfdGoAddConfidentialTxIn(handle uintptr, txHex string, txid string, vout ui
  * return: outputTxHex        output transaction hex
  * return: err                error
  */
-func CfdGoAddConfidentialTxOut(handle uintptr, txHex string, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
-	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
-	ret := CfdAddConfidentialTxOut(handle, txHex, asset, satoshiPtr, valueCommitment, address, directLockingScript, nonce, &outputTxHex)
-	err = convertCfdError(ret, handle)
-	return outputTxHex, err
+func CfdGoAddConfidentialTxOut(handle uintptr, txHex string, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdAddConfidentialTxOut(handle, txHex, asset, satoshiPtr, valueCommitment, address, directLockingScript, nonce, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoAddConfidentialTxOut")
+	return outputTxHex, err
+}
+
+/**
+ * Update txout of confidential transaction.
+ * param: handle              cfd handle
+ * param: txHex               transaction hex
+ * param: index               txout index
+ * param: asset               asset
+ * param: satoshiAmount       amount by satoshi
+ * param: valueCommitment     amount by commitment bytes.
+ * param: address             destination address
+ * param: directLockingScript  lockingScript for direct insert.
+ * param: nonce               confidential nonce
+ * return: outputTxHex        output transaction hex
+ * return: err                error
+ */
+func CfdGoUpdateConfidentialTxOut(handle uintptr, txHex string, index uint32, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdUpdateConfidentialTxOut(handle, txHex, indexPtr, asset, satoshiPtr, valueCommitment, address, directLockingScript, nonce, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoUpdateConfidentialTxOut")
+	return outputTxHex, err
+}
+
+/**
+ * Transaction data struct.
+ */
+type CfdTxData struct {
+	// txid
+	Txid string
+	// witness txid
+	Wtxid string
+	// witness only hash (elements only)
+	WitHash string
+	// transaction size
+	Size uint32
+	// virtual transaction size
+	Vsize uint32
+	// transaction weight
+	Weight uint32
+	// transaction version
+	Version uint32
+	// locktime
+	LockTime uint32
+}
+
+/**
+ * Get confidential transaction data.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * return: data         transaction data
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxData(handle uintptr, txHex string) (data CfdTxData, err error) {
+	sizePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&data.Size)))
+	vsizePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&data.Vsize)))
+	weightPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&data.Weight)))
+	versionPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&data.Version)))
+	locktimePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&data.LockTime)))
+	ret := CfdGetConfidentialTxInfo(handle, txHex, &data.Txid, &data.Wtxid, &data.WitHash, sizePtr, vsizePtr, weightPtr, versionPtr, locktimePtr)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxData")
+	return data, err
+}
+
+/**
+ * Get txin on confidential transaction.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: index         txin index
+ * return: txid         txid
+ * return: vout         vout
+ * return: sequence     sequence
+ * return: scriptSig    unlockingScript
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxIn(handle uintptr, txHex string, index uint32) (txid string, vout uint32, sequence uint32, scriptSig string, err error) {
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	sequencePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&sequence)))
+	ret := CfdGetConfidentialTxIn(handle, txHex, indexPtr, &txid, voutPtr, sequencePtr, &scriptSig)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxIn")
+	return txid, vout, sequence, scriptSig, err
+}
+
+/**
+ * Get witness stack on confidential transaction input.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: txinIndex     txin index
+ * param: stackIndex    witness stack index
+ * return: stackData    witness stack data
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxInWitness(handle uintptr, txHex string, txinIndex uint32, stackIndex uint32) (stackData string, err error) {
+	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
+	stackIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&stackIndex)))
+	ret := CfdGetConfidentialTxInWitness(handle, txHex, txinIndexPtr, stackIndexPtr, &stackData)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxInWitness")
+	return stackData, err
+}
+
+/**
+ * Get txin issuance on confidential transaction.
+ * param: handle            cfd handle
+ * param: txHex             transaction hex
+ * param: index             txin index
+ * return: entropy          blinding asset entropy
+ * return: nonce            blinding nonce
+ * return: assetAmount      asset amount value
+ * return: assetValue       asset commitment value
+ * return: tokenAmount      token amount value
+ * return: tokenValue       token commitment value
+ * return: assetRangeproof  asset rangeproof
+ * return: tokenRangeproof  token rangeproof
+ * return: err              error
+ */
+func CfdGoGetTxInIssuanceInfo(handle uintptr, txHex string, index uint32) (entropy string, nonce string, assetAmount int64, assetValue string, tokenAmount int64, tokenValue string, assetRangeproof string, tokenRangeproof string, err error) {
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	assetAmountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetAmount)))
+	tokenAmountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&tokenAmount)))
+	ret := CfdGetTxInIssuanceInfo(handle, txHex, indexPtr, &entropy, &nonce, assetAmountPtr, &assetValue, tokenAmountPtr, &tokenValue, &assetRangeproof, &tokenRangeproof)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInIssuanceInfo")
+	return entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err
+}
+
+/**
+ * Get txout on confidential transaction.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: index         txin index
+ * return: asset            asset
+ * return: satoshiAmount    amount by satoshi
+ * return: valueCommitment  amount by commitment bytes.
+ * return: nonce            confidential nonce
+ * return: lockingScript    locking script
+ * return: surjectionProof  asset surjection proof.
+ * return: rangeproof       amount rangeproof.
+ * return: err              error
+ */
+func CfdGoGetConfidentialTxOut(handle uintptr, txHex string, index uint32) (asset string, satoshiAmount int64, valueCommitment string, nonce string, lockingScript string, surjectionProof string, rangeproof string, err error) {
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdGetConfidentialTxOut(handle, txHex, indexPtr, &asset, satoshiPtr, &valueCommitment, &nonce, &lockingScript, &surjectionProof, &rangeproof)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxOut")
+	return asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err
+}
+
+/**
+ * Get txin count on confidential transaction.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * return: count        txin count
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxInCount(handle uintptr, txHex string) (count uint32, err error) {
+	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
+	ret := CfdGetConfidentialTxInCount(handle, txHex, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxInCount")
+	return count, err
+}
+
+/**
+ * Get witness stack count on confidential transaction input.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: txinIndex     txin index
+ * return: count        witness stack count
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxInWitnessCount(handle uintptr, txHex string, txinIndex uint32) (count uint32, err error) {
+	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
+	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
+	ret := CfdGetConfidentialTxInWitnessCount(handle, txHex, txinIndexPtr, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxInWitnessCount")
+	return count, err
+}
+
+/**
+ * Get txout count on confidential transaction.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * return: count        txout count
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxOutCount(handle uintptr, txHex string) (count uint32, err error) {
+	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
+	ret := CfdGetConfidentialTxOutCount(handle, txHex, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxOutCount")
+	return count, err
+}
+
+/**
+ * Initialize transaction data handle.
+ * detail: the transaction is parsed once, and can be read by the ByHandle APIs.
+ * param: handle          cfd handle
+ * param: networkType     network type
+ * param: txHex           transaction hex
+ * return: txDataHandle   transaction data handle. release: CfdGoFreeTxDataHandle
+ * return: err            error
+ */
+func CfdGoInitializeTxDataHandle(handle uintptr, networkType NetworkType, txHex string) (txDataHandle uintptr, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoInitializeTxDataHandle")
+		return
+	}
+	ret := CfdInitializeTxDataHandle(handle, int(getNativeNetworkType(networkType)), txHex, &txDataHandle)
+	err = convertCfdError(ret, handle, "CfdGoInitializeTxDataHandle")
+	return txDataHandle, err
 }
 
 /**
- * Update txout of confidential transaction.
- * param: handle              cfd handle
- * param: txHex               transaction hex
- * param: index               txout index
- * param: asset               asset
- * param: satoshiAmount       amount by satoshi
- * param: valueCommitment     amount by commitment bytes.
- * param: address             destination address
- * param: directLockingScript  lockingScript for direct insert.
- * param: nonce               confidential nonce
- * return: outputTxHex        output transaction hex
- * return: err                error
+ * Free transaction data handle.
+ * param: handle          cfd handle
+ * param: txDataHandle    transaction data handle
+ * return: err            error
  */
-func CfdGoUpdateConfidentialTxOut(handle uintptr, txHex string, index uint32, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
-	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
-	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
-	ret := CfdUpdateConfidentialTxOut(handle, txHex, indexPtr, asset, satoshiPtr, valueCommitment, address, directLockingScript, nonce, &outputTxHex)
-	err = convertCfdError(ret, handle)
-	return outputTxHex, err
+func CfdGoFreeTxDataHandle(handle uintptr, txDataHandle uintptr) (err error) {
+	ret := CfdFreeTxDataHandle(handle, txDataHandle)
+	err = convertCfdError(ret, handle, "CfdGoFreeTxDataHandle")
+	return
 }
 
 /**
- * Get txin on confidential transaction.
+ * Get confidential transaction data from transaction data handle.
  * param: handle        cfd handle
- * param: txHex         transaction hex
+ * param: txDataHandle  transaction data handle
+ * return: data         transaction data
+ * return: err          error
+ */
+func CfdGoGetConfidentialTxDataByHandle(handle uintptr, txDataHandle uintptr) (data CfdTxData, err error) {
+	var txid, wtxid, witHash string
+	var size, vsize, weight, version, locktime uint32
+	sizePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&size)))
+	vsizePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vsize)))
+	weightPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&weight)))
+	versionPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&version)))
+	locktimePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&locktime)))
+	ret := CfdGetConfidentialTxInfoByHandle(handle, txDataHandle, &txid, &wtxid, &witHash, sizePtr, vsizePtr, weightPtr, versionPtr, locktimePtr)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxDataByHandle")
+	data = CfdTxData{
+		Txid:     txid,
+		Wtxid:    wtxid,
+		WitHash:  witHash,
+		Size:     size,
+		Vsize:    vsize,
+		Weight:   weight,
+		Version:  version,
+		LockTime: locktime,
+	}
+	return data, err
+}
+
+/**
+ * Get txin from transaction data handle.
+ * param: handle        cfd handle
+ * param: txDataHandle  transaction data handle
  * param: index         txin index
  * return: txid         txid
  * return: vout         vout
@@ -1954,36 +3549,19 @@ func CfdGoUpdateConfidentialTxOut(handle uintptr, txHex string, index uint32, as
  * return: scriptSig    unlockingScript
  * return: err          error
  */
-func CfdGoGetConfidentialTxIn(handle uintptr, txHex string, index uint32) (txid string, vout uint32, sequence uint32, scriptSig string, err error) {
+func CfdGoGetTxInByHandle(handle uintptr, txDataHandle uintptr, index uint32) (txid string, vout uint32, sequence uint32, scriptSig string, err error) {
 	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	sequencePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&sequence)))
-	ret := CfdGetConfidentialTxIn(handle, txHex, indexPtr, &txid, voutPtr, sequencePtr, &scriptSig)
-	err = convertCfdError(ret, handle)
+	ret := CfdGetTxInByHandle(handle, txDataHandle, indexPtr, &txid, voutPtr, sequencePtr, &scriptSig)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInByHandle")
 	return txid, vout, sequence, scriptSig, err
 }
 
 /**
- * Get witness stack on confidential transaction input.
- * param: handle        cfd handle
- * param: txHex         transaction hex
- * param: txinIndex     txin index
- * param: stackIndex    witness stack index
- * return: stackData    witness stack data
- * return: err          error
- */
-func CfdGoGetConfidentialTxInWitness(handle uintptr, txHex string, txinIndex uint32, stackIndex uint32) (stackData string, err error) {
-	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
-	stackIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&stackIndex)))
-	ret := CfdGetConfidentialTxInWitness(handle, txHex, txinIndexPtr, stackIndexPtr, &stackData)
-	err = convertCfdError(ret, handle)
-	return stackData, err
-}
-
-/**
- * Get txin issuance on confidential transaction.
+ * Get txin issuance from transaction data handle.
  * param: handle            cfd handle
- * param: txHex             transaction hex
+ * param: txDataHandle      transaction data handle
  * param: index             txin index
  * return: entropy          blinding asset entropy
  * return: nonce            blinding nonce
@@ -1995,20 +3573,69 @@ func CfdGoGetConfidentialTxInWitness(handle uintptr, txHex string, txinIndex uin
  * return: tokenRangeproof  token rangeproof
  * return: err              error
  */
-func CfdGoGetTxInIssuanceInfo(handle uintptr, txHex string, index uint32) (entropy string, nonce string, assetAmount int64, assetValue string, tokenAmount int64, tokenValue string, assetRangeproof string, tokenRangeproof string, err error) {
+func CfdGoGetTxInIssuanceInfoByHandle(handle uintptr, txDataHandle uintptr, index uint32) (entropy string, nonce string, assetAmount int64, assetValue string, tokenAmount int64, tokenValue string, assetRangeproof string, tokenRangeproof string, err error) {
 	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
 	assetAmountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetAmount)))
 	tokenAmountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&tokenAmount)))
-	ret := CfdGetTxInIssuanceInfo(handle, txHex, indexPtr, &entropy, &nonce, assetAmountPtr, &assetValue, tokenAmountPtr, &tokenValue, &assetRangeproof, &tokenRangeproof)
-	err = convertCfdError(ret, handle)
+	ret := CfdGetTxInIssuanceInfoByHandle(handle, txDataHandle, indexPtr, &entropy, &nonce, assetAmountPtr, &assetValue, tokenAmountPtr, &tokenValue, &assetRangeproof, &tokenRangeproof)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInIssuanceInfoByHandle")
 	return entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err
 }
 
+// witness stack type of the ByHandle APIs
+const (
+	kCfdTxWitnessStackNormal int = 0
+	kCfdTxWitnessStackPegin  int = 1
+)
+
 /**
- * Get txout on confidential transaction.
+ * Get witness stack count from transaction data handle.
+ * param: handle          cfd handle
+ * param: txDataHandle    transaction data handle
+ * param: isPeginWitness  target is pegin witness
+ * param: txinIndex       txin index
+ * return: count          witness stack count
+ * return: err            error
+ */
+func CfdGoGetTxInWitnessCountByHandle(handle uintptr, txDataHandle uintptr, isPeginWitness bool, txinIndex uint32) (count uint32, err error) {
+	stackType := kCfdTxWitnessStackNormal
+	if isPeginWitness {
+		stackType = kCfdTxWitnessStackPegin
+	}
+	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
+	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
+	ret := CfdGetTxInWitnessCountByHandle(handle, txDataHandle, stackType, txinIndexPtr, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInWitnessCountByHandle")
+	return count, err
+}
+
+/**
+ * Get witness stack from transaction data handle.
+ * param: handle          cfd handle
+ * param: txDataHandle    transaction data handle
+ * param: isPeginWitness  target is pegin witness
+ * param: txinIndex       txin index
+ * param: stackIndex      witness stack index
+ * return: stackData      witness stack data
+ * return: err            error
+ */
+func CfdGoGetTxInWitnessByHandle(handle uintptr, txDataHandle uintptr, isPeginWitness bool, txinIndex uint32, stackIndex uint32) (stackData string, err error) {
+	stackType := kCfdTxWitnessStackNormal
+	if isPeginWitness {
+		stackType = kCfdTxWitnessStackPegin
+	}
+	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
+	stackIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&stackIndex)))
+	ret := CfdGetTxInWitnessByHandle(handle, txDataHandle, stackType, txinIndexPtr, stackIndexPtr, &stackData)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInWitnessByHandle")
+	return stackData, err
+}
+
+/**
+ * Get txout from transaction data handle.
  * param: handle        cfd handle
- * param: txHex         transaction hex
- * param: index         txin index
+ * param: txDataHandle  transaction data handle
+ * param: index         txout index
  * return: asset            asset
  * return: satoshiAmount    amount by satoshi
  * return: valueCommitment  amount by commitment bytes.
@@ -2018,56 +3645,57 @@ func CfdGoGetTxInIssuanceInfo(handle uintptr, txHex string, index uint32) (entro
  * return: rangeproof       amount rangeproof.
  * return: err              error
  */
-func CfdGoGetConfidentialTxOut(handle uintptr, txHex string, index uint32) (asset string, satoshiAmount int64, valueCommitment string, nonce string, lockingScript string, surjectionProof string, rangeproof string, err error) {
+func CfdGoGetConfidentialTxOutByHandle(handle uintptr, txDataHandle uintptr, index uint32) (asset string, satoshiAmount int64, valueCommitment string, nonce string, lockingScript string, surjectionProof string, rangeproof string, err error) {
 	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
 	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
-	ret := CfdGetConfidentialTxOut(handle, txHex, indexPtr, &asset, satoshiPtr, &valueCommitment, &nonce, &lockingScript, &surjectionProof, &rangeproof)
-	err = convertCfdError(ret, handle)
+	ret := CfdGetConfidentialTxOutByHandle(handle, txDataHandle, indexPtr, &asset, satoshiPtr, &valueCommitment, &nonce, &lockingScript, &surjectionProof, &rangeproof)
+	err = convertCfdError(ret, handle, "CfdGoGetConfidentialTxOutByHandle")
 	return asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err
 }
 
 /**
- * Get txin count on confidential transaction.
+ * Get txin count from transaction data handle.
  * param: handle        cfd handle
- * param: txHex         transaction hex
+ * param: txDataHandle  transaction data handle
  * return: count        txin count
  * return: err          error
  */
-func CfdGoGetConfidentialTxInCount(handle uintptr, txHex string) (count uint32, err error) {
+func CfdGoGetTxInCountByHandle(handle uintptr, txDataHandle uintptr) (count uint32, err error) {
 	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
-	ret := CfdGetConfidentialTxInCount(handle, txHex, countPtr)
-	err = convertCfdError(ret, handle)
+	ret := CfdGetTxInCountByHandle(handle, txDataHandle, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInCountByHandle")
 	return count, err
 }
 
 /**
- * Get witness stack count on confidential transaction input.
+ * Get txout count from transaction data handle.
  * param: handle        cfd handle
- * param: txHex         transaction hex
- * param: txinIndex     txin index
- * return: count        witness stack count
+ * param: txDataHandle  transaction data handle
+ * return: count        txout count
  * return: err          error
  */
-func CfdGoGetConfidentialTxInWitnessCount(handle uintptr, txHex string, txinIndex uint32) (count uint32, err error) {
-	txinIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&txinIndex)))
+func CfdGoGetTxOutCountByHandle(handle uintptr, txDataHandle uintptr) (count uint32, err error) {
 	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
-	ret := CfdGetConfidentialTxInWitnessCount(handle, txHex, txinIndexPtr, countPtr)
-	err = convertCfdError(ret, handle)
+	ret := CfdGetTxOutCountByHandle(handle, txDataHandle, countPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetTxOutCountByHandle")
 	return count, err
 }
 
 /**
- * Get txout count on confidential transaction.
+ * Get txin index from transaction data handle.
  * param: handle        cfd handle
- * param: txHex         transaction hex
- * return: count        txout count
+ * param: txDataHandle  transaction data handle
+ * param: txid          txin txid
+ * param: vout          txin vout
+ * return: index        txin index
  * return: err          error
  */
-func CfdGoGetConfidentialTxOutCount(handle uintptr, txHex string) (count uint32, err error) {
-	countPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&count)))
-	ret := CfdGetConfidentialTxOutCount(handle, txHex, countPtr)
-	err = convertCfdError(ret, handle)
-	return count, err
+func CfdGoGetTxInIndexByHandle(handle uintptr, txDataHandle uintptr, txid string, vout uint32) (index uint32, err error) {
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	ret := CfdGetTxInIndexByHandle(handle, txDataHandle, txid, voutPtr, indexPtr)
+	err = convertCfdError(ret, handle, "CfdGoGetTxInIndexByHandle")
+	return index, err
 }
 
 /**
@@ -2089,10 +3717,40 @@ func CfdGoSetRawReissueAsset(handle uintptr, txHex string, txid string, vout uin
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetSatoshiAmount)))
 	ret := CfdSetRawReissueAsset(handle, txHex, txid, voutPtr, satoshiPtr, blindingNonce, entropy, address, directLockingScript, &asset, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoSetRawReissueAsset")
 	return asset, outputTxHex, err
 }
 
+/**
+ * Set new asset issuance to confidential transaction.
+ * detail: the issued asset and token are added to txout with explicit value.
+ * param: handle               cfd handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: contractHash         contract hash (empty if not using contract)
+ * param: assetSatoshiAmount   issue asset amount
+ * param: assetAddress         asset destination address
+ * param: assetLockingScript   asset destination locking script (if address is empty)
+ * param: tokenSatoshiAmount   issue token amount (0 if not issue token)
+ * param: tokenAddress         token destination address
+ * param: tokenLockingScript   token destination locking script (if address is empty)
+ * param: isBlindAsset         blind issuance flag (for calculate token)
+ * return: entropy             asset entropy
+ * return: asset               issued asset
+ * return: token               reissuance token
+ * return: outputTxHex         output transaction hex
+ * return: err                 error
+ */
+func CfdGoSetRawIssueAsset(handle uintptr, txHex string, txid string, vout uint32, contractHash string, assetSatoshiAmount int64, assetAddress string, assetLockingScript string, tokenSatoshiAmount int64, tokenAddress string, tokenLockingScript string, isBlindAsset bool) (entropy string, asset string, token string, outputTxHex string, err error) {
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	assetSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetSatoshiAmount)))
+	tokenSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&tokenSatoshiAmount)))
+	ret := CfdSetRawIssueAsset(handle, txHex, txid, voutPtr, contractHash, assetSatoshiPtr, assetAddress, assetLockingScript, tokenSatoshiPtr, tokenAddress, tokenLockingScript, isBlindAsset, &entropy, &asset, &token, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoSetRawIssueAsset")
+	return entropy, asset, token, outputTxHex, err
+}
+
 /**
  * Get issuance blinding key.
  * param: handle               cfd handle
@@ -2105,7 +3763,7 @@ func CfdGoSetRawReissueAsset(handle uintptr, txHex string, txid string, vout uin
 func CfdGoGetIssuanceBlindingKey(handle uintptr, masterBlindingKey string, txid string, vout uint32) (blindingKey string, err error) {
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	ret := CfdGetIssuanceBlindingKey(handle, masterBlindingKey, txid, voutPtr, &blindingKey)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoGetIssuanceBlindingKey")
 	return blindingKey, err
 }
 
@@ -2117,7 +3775,7 @@ func CfdGoGetIssuanceBlindingKey(handle uintptr, masterBlindingKey string, txid
  */
 func CfdGoInitializeBlindTx(handle uintptr) (blindHandle uintptr, err error) {
 	ret := CfdInitializeBlindTx(handle, &blindHandle)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoInitializeBlindTx")
 	return blindHandle, err
 }
 
@@ -2139,7 +3797,7 @@ func CfdGoAddBlindTxInData(handle uintptr, blindHandle uintptr, txid string, vou
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
 	ret := CfdAddBlindTxInData(handle, blindHandle, txid, voutPtr, asset, assetBlindFactor, valueBlindFactor, satoshiPtr, assetKey, tokenKey)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoAddBlindTxInData")
 	return err
 }
 
@@ -2154,7 +3812,7 @@ func CfdGoAddBlindTxInData(handle uintptr, blindHandle uintptr, txid string, vou
 func CfdGoAddBlindTxOutData(handle uintptr, blindHandle uintptr, index uint32, confidentialKey string) (err error) {
 	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
 	ret := CfdAddBlindTxOutData(handle, blindHandle, indexPtr, confidentialKey)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoAddBlindTxOutData")
 	return err
 }
 
@@ -2168,7 +3826,7 @@ func CfdGoAddBlindTxOutData(handle uintptr, blindHandle uintptr, index uint32, c
  */
 func CfdGoFinalizeBlindTx(handle uintptr, blindHandle uintptr, txHex string) (outputTxHex string, err error) {
 	ret := CfdFinalizeBlindTx(handle, blindHandle, txHex, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoFinalizeBlindTx")
 	return outputTxHex, err
 }
 
@@ -2180,7 +3838,7 @@ func CfdGoFinalizeBlindTx(handle uintptr, blindHandle uintptr, txHex string) (ou
  */
 func CfdGoFreeBlindHandle(handle uintptr, blindHandle uintptr) (err error) {
 	ret := CfdFreeBlindHandle(handle, blindHandle)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoFreeBlindHandle")
 	return
 }
 
@@ -2199,7 +3857,7 @@ func CfdGoFreeBlindHandle(handle uintptr, blindHandle uintptr) (err error) {
 func CfdGoAddConfidentialTxSign(handle uintptr, txHex string, txid string, vout uint32, isWitness bool, signDataHex string, clearStack bool) (outputTxHex string, err error) {
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	ret := CfdAddConfidentialTxSign(handle, txHex, txid, voutPtr, isWitness, signDataHex, clearStack, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoAddConfidentialTxSign")
 	return outputTxHex, err
 }
 
@@ -2217,10 +3875,14 @@ func CfdGoAddConfidentialTxSign(handle uintptr, txHex string, txid string, vout
  * return: outputTxHex         output transaction hex
  * return: err                 error
  */
-func CfdGoAddConfidentialTxDerSign(handle uintptr, txHex string, txid string, vout uint32, isWitness bool, signDataHex string, sighashType int, sighashAnyoneCanPay bool, clearStack bool) (outputTxHex string, err error) {
+func CfdGoAddConfidentialTxDerSign(handle uintptr, txHex string, txid string, vout uint32, isWitness bool, signDataHex string, sighashType SigHashType, sighashAnyoneCanPay bool, clearStack bool) (outputTxHex string, err error) {
+	if err = validateEnumTypes(sighashType); err != nil {
+		err = convertGoError(err, "CfdGoAddConfidentialTxDerSign")
+		return
+	}
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
-	ret := CfdAddConfidentialTxDerSign(handle, txHex, txid, voutPtr, isWitness, signDataHex, sighashType, sighashAnyoneCanPay, clearStack, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	ret := CfdAddConfidentialTxDerSign(handle, txHex, txid, voutPtr, isWitness, signDataHex, int(sighashType), sighashAnyoneCanPay, clearStack, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoAddConfidentialTxDerSign")
 	return outputTxHex, err
 }
 
@@ -2238,10 +3900,14 @@ func CfdGoAddConfidentialTxDerSign(handle uintptr, txHex string, txid string, vo
  * return: outputTxHex         output transaction hex
  * return: err                 error
  */
-func CfdGoFinalizeElementsMultisigSign(handle uintptr, multiSignHandle uintptr, txHex string, txid string, vout uint32, hashType int, witnessScript string, redeemScript string, clearStack bool) (outputTxHex string, err error) {
+func CfdGoFinalizeElementsMultisigSign(handle uintptr, multiSignHandle uintptr, txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, clearStack bool) (outputTxHex string, err error) {
+	if err = validateEnumTypes(hashType); err != nil {
+		err = convertGoError(err, "CfdGoFinalizeElementsMultisigSign")
+		return
+	}
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
-	ret := CfdFinalizeElementsMultisigSign(handle, multiSignHandle, txHex, txid, voutPtr, hashType, witnessScript, redeemScript, clearStack, &outputTxHex)
-	err = convertCfdError(ret, handle)
+	ret := CfdFinalizeElementsMultisigSign(handle, multiSignHandle, txHex, txid, voutPtr, int(hashType), witnessScript, redeemScript, clearStack, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoFinalizeElementsMultisigSign")
 	return outputTxHex, err
 }
 
@@ -2257,14 +3923,104 @@ func CfdGoFinalizeElementsMultisigSign(handle uintptr, multiSignHandle uintptr,
  * return: outputTxHex         output transaction hex
  * return: err                 error
  */
-func CfdGoCreateConfidentialSighash(handle uintptr, txHex string, txid string, vout uint32, hashType int, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string, sighashType int, sighashAnyoneCanPay bool) (sighash string, err error) {
+func CfdGoCreateConfidentialSighash(handle uintptr, txHex string, txid string, vout uint32, hashType HashType, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string, sighashType SigHashType, sighashAnyoneCanPay bool) (sighash string, err error) {
+	if err = validateEnumTypes(hashType, sighashType); err != nil {
+		err = convertGoError(err, "CfdGoCreateConfidentialSighash")
+		return
+	}
 	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
 	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
-	ret := CfdCreateConfidentialSighash(handle, txHex, txid, voutPtr, hashType, pubkey, redeemScript, satoshiPtr, valueCommitment, sighashType, sighashAnyoneCanPay, &sighash)
-	err = convertCfdError(ret, handle)
+	ret := CfdCreateConfidentialSighash(handle, txHex, txid, voutPtr, int(hashType), pubkey, redeemScript, satoshiPtr, valueCommitment, int(sighashType), sighashAnyoneCanPay, &sighash)
+	err = convertCfdError(ret, handle, "CfdGoCreateConfidentialSighash")
 	return sighash, err
 }
 
+/**
+ * Verify signed confidential transaction input.
+ * detail: supported locking script are pubkeyhash, scripthash,
+ *         witness_v0_keyhash and witness_v0_scripthash.
+ * param: handle           cfd handle
+ * param: txHex            transaction hex
+ * param: txid             txin txid
+ * param: vout             txin vout
+ * param: lockingScript    prevout locking script hex
+ * param: satoshiAmount    prevout amount by satoshi
+ * param: valueCommitment  prevout value commitment hex (prior to satoshiAmount)
+ * return: isSuccess       verify result
+ * return: failReason      failure reason (empty if isSuccess is true)
+ * return: err             error
+ */
+func CfdGoVerifyConfidentialTxSign(handle uintptr, txHex string, txid string, vout uint32, lockingScript string, satoshiAmount int64, valueCommitment string) (isSuccess bool, failReason string, err error) {
+	addressType, isSupported, err := getVerifyAddressType(handle, txHex, txid, vout, lockingScript)
+	if err != nil {
+		err = convertGoError(err, "CfdGoVerifyConfidentialTxSign")
+		return
+	} else if !isSupported {
+		return false, verifyErrUnsupportedScript, nil
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdVerifyConfidentialTxSign(handle, txHex, txid, voutPtr, "", int(addressType), lockingScript, satoshiPtr, valueCommitment)
+	if ret == (int)(KCfdSuccess) {
+		isSuccess = true
+	} else if ret == (int)(KCfdSignVerificationError) {
+		failReason = convertCfdError(ret, handle, "CfdGoVerifyConfidentialTxSign").(*CfdError).Message
+	} else {
+		err = convertCfdError(ret, handle, "CfdGoVerifyConfidentialTxSign")
+	}
+	return isSuccess, failReason, err
+}
+
+/**
+ * Verify signature of confidential transaction input.
+ * param: handle           cfd handle
+ * param: txHex            transaction hex
+ * param: txid             txin txid
+ * param: vout             txin vout
+ * param: hashType         hash type
+ * param: signature        der signature hex (with sighash type byte)
+ * param: pubkey           pubkey hex
+ * param: redeemScript     redeem script hex (p2sh, p2wsh, p2sh-p2wsh)
+ * param: satoshiAmount    prevout amount by satoshi
+ * param: valueCommitment  prevout value commitment hex (prior to satoshiAmount)
+ * return: isVerify        verify result
+ * return: err             error
+ */
+func CfdGoVerifyConfidentialTxSignature(handle uintptr, txHex string, txid string, vout uint32, hashType HashType, signature string, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string) (isVerify bool, err error) {
+	if err = validateEnumTypes(hashType); err != nil {
+		err = convertGoError(err, "CfdGoVerifyConfidentialTxSignature")
+		return
+	}
+	script := ""
+	witnessVersion := witnessVersion0
+	switch hashType {
+	case KCfdP2pkh:
+		witnessVersion = witnessVersionNone
+	case KCfdP2sh:
+		script = redeemScript
+		witnessVersion = witnessVersionNone
+	case KCfdP2wsh, KCfdP2shP2wsh:
+		script = redeemScript
+	}
+	if script == "" && (hashType == KCfdP2sh || hashType == KCfdP2wsh || hashType == KCfdP2shP2wsh) {
+		err = convertGoError(errors.New("Invalid redeem script."), "CfdGoVerifyConfidentialTxSignature")
+		return
+	}
+	compactSignature, sighashType, sighashAnyoneCanPay, err := CfdGoDecodeSignatureFromDer(handle, signature)
+	if err != nil {
+		return false, err
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdVerifyConfidentialTxSignature(handle, txHex, compactSignature, pubkey, script, txid, voutPtr, int(sighashType), sighashAnyoneCanPay, satoshiPtr, valueCommitment, witnessVersion)
+	if ret == (int)(KCfdSuccess) {
+		isVerify = true
+	} else if ret != (int)(KCfdSignVerificationError) {
+		err = convertCfdError(ret, handle, "CfdGoVerifyConfidentialTxSignature")
+	}
+	return isVerify, err
+}
+
 /**
  * Unblind txout on confidential transaction.
  * param: handle               cfd handle
@@ -2281,7 +4037,7 @@ func CfdGoUnblindTxOut(handle uintptr, txHex string, index uint32, blindingKey s
 	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
 	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
 	ret := CfdUnblindTxOut(handle, txHex, indexPtr, blindingKey, &asset, satoshiPtr, &assetBlindFactor, &valueBlindFactor)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoUnblindTxOut")
 	return asset, satoshiAmount, assetBlindFactor, valueBlindFactor, err
 }
 
@@ -2302,13 +4058,255 @@ func CfdGoUnblindTxOut(handle uintptr, txHex string, index uint32, blindingKey s
  * return: tokenValueBlindFactor  issueToken value blind factor
  * return: err                 error
  */
-func CfdGoUnblindIssuance(handle uintptr, txHex string, index uint32, assetBlindingKey string, tokenBlindingKey string) (asset string, assetAmount int64, assetBlindFactor string, assetValueBlindFactor string, token string, tokenAmount int64, tokenBlindFactor string, tokenValueBlindFactor string, err error) {
-	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
-	assetSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetAmount)))
-	tokenSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&tokenAmount)))
-	ret := CfdUnblindIssuance(handle, txHex, indexPtr, assetBlindingKey, tokenBlindingKey, &asset, assetSatoshiPtr, &assetBlindFactor, &assetValueBlindFactor, &token, tokenSatoshiPtr, &tokenBlindFactor, &tokenValueBlindFactor)
-	err = convertCfdError(ret, handle)
-	return asset, assetAmount, assetBlindFactor, assetValueBlindFactor, token, tokenAmount, tokenBlindFactor, tokenValueBlindFactor, err
+func CfdGoUnblindIssuance(handle uintptr, txHex string, index uint32, assetBlindingKey string, tokenBlindingKey string) (asset string, assetAmount int64, assetBlindFactor string, assetValueBlindFactor string, token string, tokenAmount int64, tokenBlindFactor string, tokenValueBlindFactor string, err error) {
+	indexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&index)))
+	assetSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&assetAmount)))
+	tokenSatoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&tokenAmount)))
+	ret := CfdUnblindIssuance(handle, txHex, indexPtr, assetBlindingKey, tokenBlindingKey, &asset, assetSatoshiPtr, &assetBlindFactor, &assetValueBlindFactor, &token, tokenSatoshiPtr, &tokenBlindFactor, &tokenValueBlindFactor)
+	err = convertCfdError(ret, handle, "CfdGoUnblindIssuance")
+	return asset, assetAmount, assetBlindFactor, assetValueBlindFactor, token, tokenAmount, tokenBlindFactor, tokenValueBlindFactor, err
+}
+
+/**
+ * Initialize transaction for creating.
+ * param: handle          cfd handle
+ * param: networkType     network type
+ * param: version         transaction version (ignored if txHex is set)
+ * param: locktime        locktime (ignored if txHex is set)
+ * param: txHex           base transaction hex (empty if creating new transaction)
+ * return: createHandle   transaction create handle. release: CfdGoFreeTransactionHandle
+ * return: err            error
+ */
+func CfdGoInitializeTransaction(handle uintptr, networkType NetworkType, version uint32, locktime uint32, txHex string) (createHandle uintptr, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoInitializeTransaction")
+		return
+	}
+	versionPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&version)))
+	locktimePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&locktime)))
+	ret := CfdInitializeTransaction(handle, int(networkType), versionPtr, locktimePtr, txHex, &createHandle)
+	err = convertCfdError(ret, handle, "CfdGoInitializeTransaction")
+	return createHandle, err
+}
+
+/**
+ * Add txin to transaction.
+ * param: handle          cfd handle
+ * param: createHandle    transaction create handle
+ * param: txid            txid
+ * param: vout            vout
+ * param: sequence        sequence
+ * return: err            error
+ */
+func CfdGoAddTransactionInput(handle uintptr, createHandle uintptr, txid string, vout uint32, sequence uint32) (err error) {
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	sequencePtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&sequence)))
+	ret := CfdAddTransactionInput(handle, createHandle, txid, voutPtr, sequencePtr)
+	err = convertCfdError(ret, handle, "CfdGoAddTransactionInput")
+	return
+}
+
+/**
+ * Add txout to transaction.
+ * param: handle              cfd handle
+ * param: createHandle        transaction create handle
+ * param: satoshiAmount       amount by satoshi
+ * param: address             destination address
+ * param: directLockingScript  locking script for direct insert.
+ * param: asset               asset (elements only)
+ * return: err                error
+ */
+func CfdGoAddTransactionOutput(handle uintptr, createHandle uintptr, satoshiAmount int64, address string, directLockingScript string, asset string) (err error) {
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdAddTransactionOutput(handle, createHandle, satoshiPtr, address, directLockingScript, asset)
+	err = convertCfdError(ret, handle, "CfdGoAddTransactionOutput")
+	return
+}
+
+/**
+ * Add pegin txin to transaction.
+ * detail: the mainchain transaction and txoutproof are checked with the outpoint.
+ * param: handle                     cfd handle
+ * param: createHandle               transaction create handle (elements)
+ * param: txid                       mainchain txid
+ * param: vout                       mainchain txout index
+ * param: amount                     pegin amount by satoshi (mainchain txout amount)
+ * param: asset                      pegin asset (L-BTC)
+ * param: mainchainGenesisBlockHash  mainchain genesis block hash
+ * param: claimScript                claim script hex (see: CfdGoGetPeginAddress)
+ * param: mainchainTxHex             mainchain transaction hex
+ * param: txoutProof                 mainchain txoutproof hex
+ * return: err                       error
+ */
+func CfdGoAddTxPeginInput(handle uintptr, createHandle uintptr, txid string, vout uint32, amount int64, asset string, mainchainGenesisBlockHash string, claimScript string, mainchainTxHex string, txoutProof string) (err error) {
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	amountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&amount)))
+	ret := CfdAddTxPeginInput(handle, createHandle, txid, voutPtr, amountPtr, asset, mainchainGenesisBlockHash, claimScript, mainchainTxHex, txoutProof)
+	err = convertCfdError(ret, handle, "CfdGoAddTxPeginInput")
+	return
+}
+
+/**
+ * Add pegout txout to transaction.
+ * param: handle                     cfd handle
+ * param: createHandle               transaction create handle (elements)
+ * param: asset                      pegout asset (L-BTC)
+ * param: amount                     pegout amount by satoshi
+ * param: mainchainNetworkType       mainchain network type (mainnet, testnet, regtest)
+ * param: elementsNetworkType        elements network type (liquidv1, elements regtest)
+ * param: mainchainGenesisBlockHash  mainchain genesis block hash
+ * param: onlinePubkey               online pubkey hex (PAK only. empty if not using PAK)
+ * param: masterOnlineKey            master online privkey (PAK only. empty if not using PAK)
+ * param: mainchainOutputDescriptor  mainchain output descriptor or xpub
+ * param: bip32Counter               bip32 counter (derivation index of the descriptor)
+ * param: whitelist                  pak whitelist hex (PAK only. empty if not using PAK)
+ * return: mainchainAddress          mainchain pegout address
+ * return: err                       error
+ */
+func CfdGoAddTxPegoutOutput(handle uintptr, createHandle uintptr, asset string, amount int64, mainchainNetworkType NetworkType, elementsNetworkType NetworkType, mainchainGenesisBlockHash string, onlinePubkey string, masterOnlineKey string, mainchainOutputDescriptor string, bip32Counter uint32, whitelist string) (mainchainAddress string, err error) {
+	if err = validatePegoutNetworkTypes(mainchainNetworkType, elementsNetworkType); err != nil {
+		err = convertGoError(err, "CfdGoAddTxPegoutOutput")
+		return
+	}
+	amountPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&amount)))
+	bip32CounterPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&bip32Counter)))
+	ret := CfdAddTxPegoutOutput(handle, createHandle, asset, amountPtr, int(getNativeNetworkType(mainchainNetworkType)), int(getNativeNetworkType(elementsNetworkType)), mainchainGenesisBlockHash, onlinePubkey, masterOnlineKey, mainchainOutputDescriptor, bip32CounterPtr, whitelist, &mainchainAddress)
+	err = convertCfdError(ret, handle, "CfdGoAddTxPegoutOutput")
+	if err == nil && isCustomNetwork(mainchainNetworkType) {
+		if mainchainAddress, err = toCustomNetworkAddress(mainchainAddress); err != nil {
+			err = convertGoError(err, "CfdGoAddTxPegoutOutput")
+		}
+	}
+	return mainchainAddress, err
+}
+
+/**
+ * Finalize transaction.
+ * param: handle          cfd handle
+ * param: createHandle    transaction create handle
+ * return: txHex          transaction hex
+ * return: err            error
+ */
+func CfdGoFinalizeTransaction(handle uintptr, createHandle uintptr) (txHex string, err error) {
+	ret := CfdFinalizeTransaction(handle, createHandle, &txHex)
+	err = convertCfdError(ret, handle, "CfdGoFinalizeTransaction")
+	return txHex, err
+}
+
+/**
+ * Free transaction create handle.
+ * param: handle          cfd handle
+ * param: createHandle    transaction create handle
+ * return: err            error
+ */
+func CfdGoFreeTransactionHandle(handle uintptr, createHandle uintptr) (err error) {
+	ret := CfdFreeTransactionHandle(handle, createHandle)
+	err = convertCfdError(ret, handle, "CfdGoFreeTransactionHandle")
+	return
+}
+
+/**
+ * Create sighash from transaction.
+ * param: handle               cfd handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: hashType             hash type
+ * param: pubkey               pubkey (p2pkh, p2wpkh, p2sh-p2wpkh)
+ * param: redeemScript         redeem script (p2sh, p2wsh, p2sh-p2wsh)
+ * param: satoshiAmount        txin amount by satoshi (p2wpkh, p2wsh, p2sh-p2wpkh, p2sh-p2wsh)
+ * param: sighashType          sighash type
+ * param: sighashAnyoneCanPay  sighash anyone can pay flag
+ * return: sighash             signature hash
+ * return: err                 error
+ */
+func CfdGoCreateSighash(handle uintptr, txHex string, txid string, vout uint32, hashType HashType, pubkey string, redeemScript string, satoshiAmount int64, sighashType SigHashType, sighashAnyoneCanPay bool) (sighash string, err error) {
+	if err = validateEnumTypes(hashType, sighashType); err != nil {
+		err = convertGoError(err, "CfdGoCreateSighash")
+		return
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	satoshiPtr := SwigcptrInt64_t(uintptr(unsafe.Pointer(&satoshiAmount)))
+	ret := CfdCreateSighash(handle, int(KCfdNetworkMainnet), txHex, txid, voutPtr, int(hashType), pubkey, redeemScript, satoshiPtr, int(sighashType), sighashAnyoneCanPay, &sighash)
+	err = convertCfdError(ret, handle, "CfdGoCreateSighash")
+	return sighash, err
+}
+
+/**
+ * Add sign data to transaction.
+ * param: handle               cfd handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: isWitness            insert sign data to witness stack
+ * param: signDataHex          sign data hex
+ * param: clearStack           cleanup stack
+ * return: outputTxHex         output transaction hex
+ * return: err                 error
+ */
+func CfdGoAddTxSign(handle uintptr, txHex string, txid string, vout uint32, isWitness bool, signDataHex string, clearStack bool) (outputTxHex string, err error) {
+	hashType := KCfdP2sh
+	if isWitness {
+		hashType = KCfdP2wsh
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	ret := CfdAddTxSign(handle, int(KCfdNetworkMainnet), txHex, txid, voutPtr, int(hashType), signDataHex, false, int(KCfdSigHashAll), false, clearStack, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoAddTxSign")
+	return outputTxHex, err
+}
+
+/**
+ * Convert to der encode, and add sign data to transaction.
+ * param: handle               cfd handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: isWitness            insert sign data to witness stack
+ * param: signDataHex          sign data hex
+ * param: sighashType          sighash type
+ * param: sighashAnyoneCanPay  sighash anyone can pay flag
+ * param: clearStack           cleanup stack
+ * return: outputTxHex         output transaction hex
+ * return: err                 error
+ */
+func CfdGoAddTxDerSign(handle uintptr, txHex string, txid string, vout uint32, isWitness bool, signDataHex string, sighashType SigHashType, sighashAnyoneCanPay bool, clearStack bool) (outputTxHex string, err error) {
+	if err = validateEnumTypes(sighashType); err != nil {
+		err = convertGoError(err, "CfdGoAddTxDerSign")
+		return
+	}
+	hashType := KCfdP2sh
+	if isWitness {
+		hashType = KCfdP2wsh
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	ret := CfdAddTxSign(handle, int(KCfdNetworkMainnet), txHex, txid, voutPtr, int(hashType), signDataHex, true, int(sighashType), sighashAnyoneCanPay, clearStack, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoAddTxDerSign")
+	return outputTxHex, err
+}
+
+/**
+ * Add multisig sign data to transaction.
+ * param: handle               cfd handle
+ * param: multiSignHandle      multisig sign handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: hashType             hash type (p2sh, p2wsh, p2sh-p2wsh)
+ * param: witnessScript        witness script (p2wsh, p2sh-p2wsh)
+ * param: redeemScript         redeem script (p2sh, p2sh-p2wsh)
+ * param: clearStack           cleanup stack
+ * return: outputTxHex         output transaction hex
+ * return: err                 error
+ */
+func CfdGoFinalizeBitcoinMultisigSign(handle uintptr, multiSignHandle uintptr, txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, clearStack bool) (outputTxHex string, err error) {
+	if err = validateEnumTypes(hashType); err != nil {
+		err = convertGoError(err, "CfdGoFinalizeBitcoinMultisigSign")
+		return
+	}
+	voutPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&vout)))
+	ret := CfdFinalizeMultisigSign(handle, multiSignHandle, int(KCfdNetworkMainnet), txHex, txid, voutPtr, int(hashType), witnessScript, redeemScript, clearStack, &outputTxHex)
+	err = convertCfdError(ret, handle, "CfdGoFinalizeBitcoinMultisigSign")
+	return outputTxHex, err
 }
 
 /**
@@ -2319,7 +4317,7 @@ func CfdGoUnblindIssuance(handle uintptr, txHex string, index uint32, assetBlind
  */
 func CfdGoInitializeMultisigSign(handle uintptr) (multisigSignHandle uintptr, err error) {
 	ret := CfdInitializeMultisigSign(handle, &multisigSignHandle)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoInitializeMultisigSign")
 	return multisigSignHandle, err
 }
 
@@ -2333,7 +4331,7 @@ func CfdGoInitializeMultisigSign(handle uintptr) (multisigSignHandle uintptr, er
  */
 func CfdGoAddMultisigSignData(handle uintptr, multisigSignHandle uintptr, signature string, relatedPubkey string) (err error) {
 	ret := CfdAddMultisigSignData(handle, multisigSignHandle, signature, relatedPubkey)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoAddMultisigSignData")
 	return
 }
 
@@ -2347,9 +4345,13 @@ func CfdGoAddMultisigSignData(handle uintptr, multisigSignHandle uintptr, signat
  * param: relatedPubkey        signature related pubkey
  * return: err                 error
  */
-func CfdGoAddMultisigSignDataToDer(handle uintptr, multisigSignHandle uintptr, signature string, sighashType int, sighashAnyoneCanPay bool, relatedPubkey string) (err error) {
-	ret := CfdAddMultisigSignDataToDer(handle, multisigSignHandle, signature, sighashType, sighashAnyoneCanPay, relatedPubkey)
-	err = convertCfdError(ret, handle)
+func CfdGoAddMultisigSignDataToDer(handle uintptr, multisigSignHandle uintptr, signature string, sighashType SigHashType, sighashAnyoneCanPay bool, relatedPubkey string) (err error) {
+	if err = validateEnumTypes(sighashType); err != nil {
+		err = convertGoError(err, "CfdGoAddMultisigSignDataToDer")
+		return
+	}
+	ret := CfdAddMultisigSignDataToDer(handle, multisigSignHandle, signature, int(sighashType), sighashAnyoneCanPay, relatedPubkey)
+	err = convertCfdError(ret, handle, "CfdGoAddMultisigSignDataToDer")
 	return
 }
 
@@ -2361,7 +4363,7 @@ func CfdGoAddMultisigSignDataToDer(handle uintptr, multisigSignHandle uintptr, s
  */
 func CfdGoFreeMultisigSignHandle(handle uintptr, multisigSignHandle uintptr) (err error) {
 	ret := CfdFreeMultisigSignHandle(handle, multisigSignHandle)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoFreeMultisigSignHandle")
 	return
 }
 
@@ -2374,8 +4376,17 @@ func CfdGoFreeMultisigSignHandle(handle uintptr, multisigSignHandle uintptr) (er
  * return: err                  error
  */
 func CfdGoCreateConfidentialAddress(handle uintptr, address string, confidentialKey string) (confidentialAddress string, err error) {
-	ret := CfdCreateConfidentialAddress(handle, address, confidentialKey, &confidentialAddress)
-	err = convertCfdError(ret, handle)
+	nativeAddress, isCustom := toNativeNetworkAddress(address)
+	if !isCustom {
+		nativeAddress = address
+	}
+	ret := CfdCreateConfidentialAddress(handle, nativeAddress, confidentialKey, &confidentialAddress)
+	err = convertCfdError(ret, handle, "CfdGoCreateConfidentialAddress")
+	if err == nil && isCustom {
+		if confidentialAddress, err = toCustomNetworkAddress(confidentialAddress); err != nil {
+			err = convertGoError(err, "CfdGoCreateConfidentialAddress")
+		}
+	}
 	return confidentialAddress, err
 }
 
@@ -2388,13 +4399,87 @@ func CfdGoCreateConfidentialAddress(handle uintptr, address string, confidential
  * return: networkType         network type
  * return: err                 error
  */
-func CfdGoParseConfidentialAddress(handle uintptr, confidentialAddress string) (address string, confidentialKey string, networkType int, err error) {
-	ret := CfdParseConfidentialAddress(handle, confidentialAddress,
-			&address, &confidentialKey, &networkType)
-	err = convertCfdError(ret, handle)
+func CfdGoParseConfidentialAddress(handle uintptr, confidentialAddress string) (address string, confidentialKey string, networkType NetworkType, err error) {
+	nativeAddress, isCustom := toNativeNetworkAddress(confidentialAddress)
+	if !isCustom {
+		nativeAddress = confidentialAddress
+	}
+	var networkTypeValue int
+	ret := CfdParseConfidentialAddress(handle, nativeAddress,
+			&address, &confidentialKey, &networkTypeValue)
+	err = convertCfdError(ret, handle, "CfdGoParseConfidentialAddress")
+	networkType = NetworkType(networkTypeValue)
+	if err == nil && isCustom {
+		networkType = KCfdNetworkCustomChain
+		if address, err = toCustomNetworkAddress(address); err != nil {
+			err = convertGoError(err, "CfdGoParseConfidentialAddress")
+		}
+	}
 	return address, confidentialKey, networkType, err
 }
 
+/**
+ * Get pegin address.
+ * param: handle               cfd handle
+ * param: mainchainNetworkType mainchain network type (mainnet, testnet, regtest)
+ * param: fedpegScript         fedpeg script hex
+ * param: hashType             pegin address hash type (p2sh-p2wsh or p2wsh)
+ * param: pubkey               claim pubkey hex (p2wpkh claim script)
+ * param: redeemScript         claim redeem script hex (p2wsh claim script. prior to pubkey)
+ * return: peginAddress        mainchain pegin address
+ * return: claimScript         claim script hex
+ * return: tweakedFedpegScript tweaked fedpeg script hex
+ * return: err                 error
+ */
+func CfdGoGetPeginAddress(handle uintptr, mainchainNetworkType NetworkType, fedpegScript string, hashType HashType, pubkey string, redeemScript string) (peginAddress string, claimScript string, tweakedFedpegScript string, err error) {
+	if _, err = getMainchainAddressPrefix(mainchainNetworkType); err == nil {
+		err = validateEnumTypes(hashType)
+	}
+	if err != nil {
+		err = convertGoError(err, "CfdGoGetPeginAddress")
+		return
+	}
+	ret := CfdGetPeginAddress(handle, int(getNativeNetworkType(mainchainNetworkType)), fedpegScript, int(hashType), pubkey, redeemScript, &peginAddress, &claimScript, &tweakedFedpegScript)
+	err = convertCfdError(ret, handle, "CfdGoGetPeginAddress")
+	if err == nil && isCustomNetwork(mainchainNetworkType) {
+		if peginAddress, err = toCustomNetworkAddress(peginAddress); err != nil {
+			err = convertGoError(err, "CfdGoGetPeginAddress")
+		}
+	}
+	return peginAddress, claimScript, tweakedFedpegScript, err
+}
+
+/**
+ * Get pegout address.
+ * param: handle               cfd handle
+ * param: mainchainNetworkType mainchain network type (mainnet, testnet, regtest)
+ * param: elementsNetworkType  elements network type (liquidv1, elements regtest)
+ * param: descriptor           mainchain output descriptor or xpub
+ * param: bip32Counter         bip32 counter (derivation index of the descriptor)
+ * param: addressType          mainchain address type (p2pkh, p2wpkh, p2sh-p2wpkh)
+ * return: mainchainAddress    mainchain pegout address
+ * return: baseDescriptor      base descriptor
+ * return: err                 error
+ */
+func CfdGoGetPegoutAddress(handle uintptr, mainchainNetworkType NetworkType, elementsNetworkType NetworkType, descriptor string, bip32Counter uint32, addressType AddressType) (mainchainAddress string, baseDescriptor string, err error) {
+	if err = validatePegoutNetworkTypes(mainchainNetworkType, elementsNetworkType); err == nil {
+		err = validateEnumTypes(addressType)
+	}
+	if err != nil {
+		err = convertGoError(err, "CfdGoGetPegoutAddress")
+		return
+	}
+	bip32CounterPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&bip32Counter)))
+	ret := CfdGetPegoutAddress(handle, int(getNativeNetworkType(mainchainNetworkType)), int(getNativeNetworkType(elementsNetworkType)), descriptor, bip32CounterPtr, int(addressType), &mainchainAddress, &baseDescriptor)
+	err = convertCfdError(ret, handle, "CfdGoGetPegoutAddress")
+	if err == nil && isCustomNetwork(mainchainNetworkType) {
+		if mainchainAddress, err = toCustomNetworkAddress(mainchainAddress); err != nil {
+			err = convertGoError(err, "CfdGoGetPegoutAddress")
+		}
+	}
+	return mainchainAddress, baseDescriptor, err
+}
+
 /**
  * Calculate ec-signature from privkey.
  * param: handle               cfd handle
@@ -2406,12 +4491,207 @@ func CfdGoParseConfidentialAddress(handle uintptr, confidentialAddress string) (
  * return: signature           signature
  * return: err                 error
  */
-func CfdGoCalculateEcSignature(handle uintptr, sighash string, privkeyHex string, privkeyWif string, wifNetworkType int, hasGrindR bool) (signature string, err error) {
-	ret := CfdCalculateEcSignature(handle, sighash, privkeyHex, privkeyWif, wifNetworkType, hasGrindR, &signature)
-	err = convertCfdError(ret, handle)
+func CfdGoCalculateEcSignature(handle uintptr, sighash string, privkeyHex string, privkeyWif string, wifNetworkType NetworkType, hasGrindR bool) (signature string, err error) {
+	if err = validateEnumTypes(wifNetworkType); err != nil {
+		err = convertGoError(err, "CfdGoCalculateEcSignature")
+		return
+	}
+	ret := CfdCalculateEcSignature(handle, sighash, privkeyHex, privkeyWif, int(wifNetworkType), hasGrindR, &signature)
+	err = convertCfdError(ret, handle, "CfdGoCalculateEcSignature")
+	return signature, err
+}
+
+/**
+ * Verify ec signature.
+ * detail: high-S signature is rejected.
+ * param: handle          cfd handle.
+ * param: sighash         signature hash.
+ * param: pubkey          pubkey hex.
+ * param: signature       signature hex. (64 bytes, not der)
+ * return: isVerify       verify result.
+ * return: err            error (verification failure is not error)
+ */
+func CfdGoVerifyEcSignature(handle uintptr, sighash string, pubkey string, signature string) (isVerify bool, err error) {
+	ret := CfdVerifyEcSignature(handle, sighash, pubkey, signature)
+	if ret == (int)(KCfdSuccess) {
+		isVerify = true
+	} else if ret != (int)(KCfdSignVerificationError) {
+		err = convertCfdError(ret, handle, "CfdGoVerifyEcSignature")
+	}
+	return isVerify, err
+}
+
+/**
+ * Encode compact signature to der signature.
+ * param: handle               cfd handle.
+ * param: signature            compact signature hex. (64 bytes)
+ * param: sighashType          sighash type.
+ * param: sighashAnyoneCanPay  sighash anyone can pay flag.
+ * return: derSignature        der encoded signature hex. (with sighash type byte)
+ * return: err                 error
+ */
+func CfdGoEncodeSignatureByDer(handle uintptr, signature string, sighashType SigHashType, sighashAnyoneCanPay bool) (derSignature string, err error) {
+	if err = sighashType.validate(); err != nil {
+		err = convertGoError(err, "CfdGoEncodeSignatureByDer")
+		return
+	}
+	ret := CfdEncodeSignatureByDer(handle, signature, int(sighashType), sighashAnyoneCanPay, &derSignature)
+	err = convertCfdError(ret, handle, "CfdGoEncodeSignatureByDer")
+	return derSignature, err
+}
+
+/**
+ * Decode der signature to compact signature.
+ * param: handle               cfd handle.
+ * param: derSignature         der encoded signature hex. (with sighash type byte)
+ * return: signature           compact signature hex. (64 bytes)
+ * return: sighashType         sighash type.
+ * return: sighashAnyoneCanPay sighash anyone can pay flag.
+ * return: err                 error
+ */
+func CfdGoDecodeSignatureFromDer(handle uintptr, derSignature string) (signature string, sighashType SigHashType, sighashAnyoneCanPay bool, err error) {
+	var sighashTypeValue int
+	ret := CfdDecodeSignatureFromDer(handle, derSignature, &signature, &sighashTypeValue, &sighashAnyoneCanPay)
+	if err = convertCfdError(ret, handle, "CfdGoDecodeSignatureFromDer"); err != nil {
+		return "", sighashType, false, err
+	}
+	sighashType = SigHashType(sighashTypeValue)
+	if err = sighashType.validate(); err != nil {
+		return "", sighashType, false, convertGoError(err, "CfdGoDecodeSignatureFromDer")
+	}
+	return signature, sighashType, sighashAnyoneCanPay, nil
+}
+
+/**
+ * Normalize ec signature to low-S form.
+ * param: handle               cfd handle.
+ * param: signature            signature hex. (64 bytes, not der)
+ * return: normalizedSignature normalized signature hex.
+ * return: err                 error
+ */
+func CfdGoNormalizeSignature(handle uintptr, signature string) (normalizedSignature string, err error) {
+	ret := CfdNormalizeSignature(handle, signature, &normalizedSignature)
+	err = convertCfdError(ret, handle, "CfdGoNormalizeSignature")
+	return normalizedSignature, err
+}
+
+/**
+ * Sign schnorr signature. (BIP340)
+ * param: handle          cfd handle.
+ * param: msg             message hex. (32 bytes. ex: sighash)
+ * param: privkey         privkey hex.
+ * param: auxRand         auxiliary random data hex. (32 bytes. empty is zero bytes)
+ * return: signature      schnorr signature hex. (64 bytes)
+ * return: err            error
+ */
+func CfdGoSignSchnorr(handle uintptr, msg string, privkey string, auxRand string) (signature string, err error) {
+	if auxRand == "" {
+		auxRand = strings.Repeat("00", 32)
+	}
+	ret := CfdSignSchnorr(handle, msg, privkey, auxRand, &signature)
+	err = convertCfdError(ret, handle, "CfdGoSignSchnorr")
 	return signature, err
 }
 
+/**
+ * Verify schnorr signature. (BIP340)
+ * param: handle          cfd handle.
+ * param: signature       schnorr signature hex. (64 bytes)
+ * param: msg             message hex. (32 bytes)
+ * param: pubkey          x-only pubkey hex.
+ * return: isVerify       verify result.
+ * return: err            error (verification failure is not error)
+ */
+func CfdGoVerifySchnorr(handle uintptr, signature string, msg string, pubkey string) (isVerify bool, err error) {
+	ret := CfdVerifySchnorr(handle, signature, msg, pubkey)
+	if ret == (int)(KCfdSuccess) {
+		isVerify = true
+	} else if ret != (int)(KCfdSignVerificationError) {
+		err = convertCfdError(ret, handle, "CfdGoVerifySchnorr")
+	}
+	return isVerify, err
+}
+
+/**
+ * Get schnorr pubkey (x-only pubkey) from privkey.
+ * param: handle          cfd handle.
+ * param: privkey         privkey hex.
+ * return: pubkey         x-only pubkey hex.
+ * return: parity         parity flag of pubkey y. (true is odd)
+ * return: err            error
+ */
+func CfdGoGetSchnorrPubkeyFromPrivkey(handle uintptr, privkey string) (pubkey string, parity bool, err error) {
+	ret := CfdGetSchnorrPubkeyFromPrivkey(handle, privkey, &pubkey, &parity)
+	err = convertCfdError(ret, handle, "CfdGoGetSchnorrPubkeyFromPrivkey")
+	return pubkey, parity, err
+}
+
+/**
+ * Get schnorr pubkey (x-only pubkey) from pubkey.
+ * param: handle          cfd handle.
+ * param: pubkey          pubkey hex. (compressed or uncompressed)
+ * return: schnorrPubkey  x-only pubkey hex.
+ * return: parity         parity flag of pubkey y. (true is odd)
+ * return: err            error
+ */
+func CfdGoGetSchnorrPubkeyFromPubkey(handle uintptr, pubkey string) (schnorrPubkey string, parity bool, err error) {
+	ret := CfdGetSchnorrPubkeyFromPubkey(handle, pubkey, &schnorrPubkey, &parity)
+	err = convertCfdError(ret, handle, "CfdGoGetSchnorrPubkeyFromPubkey")
+	return schnorrPubkey, parity, err
+}
+
+/**
+ * Tweak add schnorr pubkey. (BIP341)
+ * param: handle          cfd handle.
+ * param: pubkey          x-only pubkey hex.
+ * param: tweak           tweak hex. (32 bytes)
+ * return: tweakedPubkey  tweaked x-only pubkey hex.
+ * return: tweakParity    parity flag of tweaked pubkey y. (true is odd)
+ * return: err            error
+ */
+func CfdGoSchnorrPubkeyTweakAdd(handle uintptr, pubkey string, tweak string) (tweakedPubkey string, tweakParity bool, err error) {
+	ret := CfdSchnorrPubkeyTweakAdd(handle, pubkey, tweak, &tweakedPubkey, &tweakParity)
+	err = convertCfdError(ret, handle, "CfdGoSchnorrPubkeyTweakAdd")
+	return tweakedPubkey, tweakParity, err
+}
+
+/**
+ * Check tweaked schnorr pubkey. (BIP341)
+ * param: handle          cfd handle.
+ * param: tweakedPubkey   tweaked x-only pubkey hex.
+ * param: tweakParity     parity flag of tweaked pubkey y. (true is odd)
+ * param: basePubkey      base x-only pubkey hex.
+ * param: tweak           tweak hex. (32 bytes)
+ * return: isValid        check result.
+ * return: err            error
+ */
+func CfdGoCheckTweakAddFromSchnorrPubkey(handle uintptr, tweakedPubkey string, tweakParity bool, basePubkey string, tweak string) (isValid bool, err error) {
+	ret := CfdCheckTweakAddFromSchnorrPubkey(handle, tweakedPubkey, tweakParity, basePubkey, tweak)
+	if ret == (int)(KCfdSuccess) {
+		isValid = true
+	} else if ret != (int)(KCfdSignVerificationError) {
+		err = convertCfdError(ret, handle, "CfdGoCheckTweakAddFromSchnorrPubkey")
+	}
+	return isValid, err
+}
+
+/**
+ * Tweak add schnorr privkey. (BIP341)
+ * detail: privkey is negated if the pubkey y is odd.
+ * param: handle          cfd handle.
+ * param: privkey         privkey hex.
+ * param: tweak           tweak hex. (32 bytes)
+ * return: tweakedPrivkey tweaked privkey hex.
+ * return: err            error
+ */
+func CfdGoSchnorrPrivkeyTweakAdd(handle uintptr, privkey string, tweak string) (tweakedPrivkey string, err error) {
+	var tweakedPubkey string
+	var tweakParity bool
+	ret := CfdSchnorrKeyPairTweakAdd(handle, privkey, tweak, &tweakedPubkey, &tweakParity, &tweakedPrivkey)
+	err = convertCfdError(ret, handle, "CfdGoSchnorrPrivkeyTweakAdd")
+	return tweakedPrivkey, err
+}
+
 /**
  * Create key pair.
  * param: handle          cfd handle.
@@ -2422,9 +4702,13 @@ func CfdGoCalculateEcSignature(handle uintptr, sighash string, privkeyHex string
  * return: privkeyWif     privkey wif.
  * return: err            error
  */
-func CfdGoCreateKeyPair(handle uintptr, isCompress bool, networkType int) (pubkey string, privkeyHex string, privkeyWif string, err error) {
-	ret := CfdCreateKeyPair(handle, isCompress, networkType, &pubkey, &privkeyHex, &privkeyWif)
-	err = convertCfdError(ret, handle)
+func CfdGoCreateKeyPair(handle uintptr, isCompress bool, networkType NetworkType) (pubkey string, privkeyHex string, privkeyWif string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoCreateKeyPair")
+		return
+	}
+	ret := CfdCreateKeyPair(handle, isCompress, int(networkType), &pubkey, &privkeyHex, &privkeyWif)
+	err = convertCfdError(ret, handle, "CfdGoCreateKeyPair")
 	return pubkey, privkeyHex, privkeyWif, err
 }
 
@@ -2436,12 +4720,35 @@ func CfdGoCreateKeyPair(handle uintptr, isCompress bool, networkType int) (pubke
  * return: privkeyHex     privkey hex.
  * return: err            error
  */
-func CfdGoGetPrivkeyFromWif(handle uintptr, privkeyWif string, networkType int) (privkeyHex string, err error) {
-	ret := CfdGetPrivkeyFromWif(handle, privkeyWif, networkType, &privkeyHex)
-	err = convertCfdError(ret, handle)
+func CfdGoGetPrivkeyFromWif(handle uintptr, privkeyWif string, networkType NetworkType) (privkeyHex string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoGetPrivkeyFromWif")
+		return
+	}
+	ret := CfdGetPrivkeyFromWif(handle, privkeyWif, int(networkType), &privkeyHex)
+	err = convertCfdError(ret, handle, "CfdGoGetPrivkeyFromWif")
 	return privkeyHex, err
 }
 
+/**
+ * Parse privkey WIF.
+ * param: handle          cfd handle.
+ * param: privkeyWif      privkey wif.
+ * return: privkeyHex     privkey hex.
+ * return: networkType    privkey wif network type.
+ * return: isCompress     pubkey compressed flag.
+ * return: err            error
+ */
+func CfdGoParsePrivkeyWif(handle uintptr, privkeyWif string) (privkeyHex string, networkType NetworkType, isCompress bool, err error) {
+	var network int
+	ret := CfdParsePrivkeyWif(handle, privkeyWif, &privkeyHex, &network, &isCompress)
+	err = convertCfdError(ret, handle, "CfdGoParsePrivkeyWif")
+	if err == nil {
+		networkType = NetworkType(network)
+	}
+	return privkeyHex, networkType, isCompress, err
+}
+
 /**
  * Get pubkey from privkey.
  * param: handle          cfd handle.
@@ -2453,10 +4760,37 @@ func CfdGoGetPrivkeyFromWif(handle uintptr, privkeyWif string, networkType int)
  */
 func CfdGoGetPubkeyFromPrivkey(handle uintptr, privkeyHex string, privkeyWif string, isCompress bool) (pubkey string, err error) {
 	ret := CfdGetPubkeyFromPrivkey(handle, privkeyHex, privkeyWif, isCompress, &pubkey)
-	err = convertCfdError(ret, handle)
+	err = convertCfdError(ret, handle, "CfdGoGetPubkeyFromPrivkey")
 	return pubkey, err
 }
 
+/**
+ * Tweak add pubkey.
+ * param: handle          cfd handle.
+ * param: pubkey          pubkey hex.
+ * param: tweak           tweak hex. (32 bytes)
+ * return: tweakedPubkey  tweaked pubkey hex.
+ * return: err            error
+ */
+func CfdGoPubkeyTweakAdd(handle uintptr, pubkey string, tweak string) (tweakedPubkey string, err error) {
+	ret := CfdPubkeyTweakAdd(handle, pubkey, tweak, &tweakedPubkey)
+	err = convertCfdError(ret, handle, "CfdGoPubkeyTweakAdd")
+	return tweakedPubkey, err
+}
+
+/**
+ * Compress pubkey.
+ * param: handle          cfd handle.
+ * param: pubkey          pubkey hex. (compressed or uncompressed)
+ * return: compressedPubkey  compressed pubkey hex.
+ * return: err            error
+ */
+func CfdGoCompressPubkey(handle uintptr, pubkey string) (compressedPubkey string, err error) {
+	ret := CfdCompressPubkey(handle, pubkey, &compressedPubkey)
+	err = convertCfdError(ret, handle, "CfdGoCompressPubkey")
+	return compressedPubkey, err
+}
+
 /**
  * Create extkey from seed.
  * param: handle          cfd handle.
@@ -2466,25 +4800,44 @@ func CfdGoGetPubkeyFromPrivkey(handle uintptr, privkeyHex string, privkeyWif str
  * return: extkey         extkey.
  * return: err            error
  */
-func CfdGoCreateExtkeyFromSeed(handle uintptr, seed string, networkType int, keyType int) (extkey string, err error) {
-	ret := CfdCreateExtkeyFromSeed(handle, seed, networkType, keyType, &extkey)
-	err = convertCfdError(ret, handle)
+func CfdGoCreateExtkeyFromSeed(handle uintptr, seed string, networkType NetworkType, keyType ExtKeyType) (extkey string, err error) {
+	if err = validateEnumTypes(networkType, keyType); err != nil {
+		err = convertGoError(err, "CfdGoCreateExtkeyFromSeed")
+		return
+	}
+	ret := CfdCreateExtkeyFromSeed(handle, seed, int(getNativeNetworkType(networkType)), int(keyType), &extkey)
+	err = convertCfdError(ret, handle, "CfdGoCreateExtkeyFromSeed")
+	if err == nil {
+		if extkey, err = fromNativeExtkey(extkey, networkType, KCfdP2pkh); err != nil {
+			err = convertGoError(err, "CfdGoCreateExtkeyFromSeed")
+		}
+	}
 	return extkey, err
 }
 
 /**
  * Create extkey from parent path.
  * param: handle          cfd handle.
- * param: extkey          parent extkey.
+ * param: extkey          parent extkey. (BIP32 or SLIP-132 version)
  * param: path            bip32 key path.(ex: 0/0h/0'/0)
  * param: networkType     network type.
  * param: keyType         extkey type.
- * return: childExtkey    child extkey.
+ * return: childExtkey    child extkey. (same version as parent extkey)
  * return: err            error
  */
-func CfdGoCreateExtkeyFromParentPath(handle uintptr, extkey string, path string, networkType int, keyType int) (childExtkey string, err error) {
-	ret := CfdCreateExtkeyFromParentPath(handle, extkey, path, networkType, keyType, &childExtkey)
-	err = convertCfdError(ret, handle)
+func CfdGoCreateExtkeyFromParentPath(handle uintptr, extkey string, path string, networkType NetworkType, keyType ExtKeyType) (childExtkey string, err error) {
+	if err = validateEnumTypes(networkType, keyType); err != nil {
+		err = convertGoError(err, "CfdGoCreateExtkeyFromParentPath")
+		return
+	}
+	extkey, versionHashType := toNativeExtkey(extkey)
+	ret := CfdCreateExtkeyFromParentPath(handle, extkey, path, int(getNativeNetworkType(networkType)), int(keyType), &childExtkey)
+	err = convertCfdError(ret, handle, "CfdGoCreateExtkeyFromParentPath")
+	if err == nil {
+		if childExtkey, err = fromNativeExtkey(childExtkey, networkType, versionHashType); err != nil {
+			err = convertGoError(err, "CfdGoCreateExtkeyFromParentPath")
+		}
+	}
 	return childExtkey, err
 }
 
@@ -2496,9 +4849,19 @@ func CfdGoCreateExtkeyFromParentPath(handle uintptr, extkey string, path string,
  * return: extPubkey      ext pubkey.
  * return: err            error
  */
-func CfdGoCreateExtPubkey(handle uintptr, extkey string, networkType int) (extPubkey string, err error) {
-	ret := CfdCreateExtPubkey(handle, extkey, networkType, &extPubkey)
-	err = convertCfdError(ret, handle)
+func CfdGoCreateExtPubkey(handle uintptr, extkey string, networkType NetworkType) (extPubkey string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoCreateExtPubkey")
+		return
+	}
+	extkey, versionHashType := toNativeExtkey(extkey)
+	ret := CfdCreateExtPubkey(handle, extkey, int(getNativeNetworkType(networkType)), &extPubkey)
+	err = convertCfdError(ret, handle, "CfdGoCreateExtPubkey")
+	if err == nil {
+		if extPubkey, err = fromNativeExtkey(extPubkey, networkType, versionHashType); err != nil {
+			err = convertGoError(err, "CfdGoCreateExtPubkey")
+		}
+	}
 	return extPubkey, err
 }
 
@@ -2511,9 +4874,19 @@ func CfdGoCreateExtPubkey(handle uintptr, extkey string, networkType int) (extPu
  * return: privkeyWif     privkey wif.
  * return: err            error
  */
-func CfdGoGetPrivkeyFromExtkey(handle uintptr, extkey string, networkType int) (privkeyHex string, privkeyWif string, err error) {
-	ret := CfdGetPrivkeyFromExtkey(handle, extkey, networkType, &privkeyHex, &privkeyWif)
-	err = convertCfdError(ret, handle)
+func CfdGoGetPrivkeyFromExtkey(handle uintptr, extkey string, networkType NetworkType) (privkeyHex string, privkeyWif string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoGetPrivkeyFromExtkey")
+		return
+	}
+	extkey, _ = toNativeExtkey(extkey)
+	ret := CfdGetPrivkeyFromExtkey(handle, extkey, int(getNativeNetworkType(networkType)), &privkeyHex, &privkeyWif)
+	err = convertCfdError(ret, handle, "CfdGoGetPrivkeyFromExtkey")
+	if err == nil && isCustomNetwork(networkType) {
+		if privkeyWif, err = encodeCustomNetworkWif(privkeyHex, true); err != nil {
+			err = convertGoError(err, "CfdGoGetPrivkeyFromExtkey")
+		}
+	}
 	return privkeyHex, privkeyWif, err
 }
 
@@ -2525,12 +4898,135 @@ func CfdGoGetPrivkeyFromExtkey(handle uintptr, extkey string, networkType int) (
  * return: pubkey         pubkey.
  * return: err            error
  */
-func CfdGoGetPubkeyFromExtkey(handle uintptr, extkey string, networkType int) (pubkey string, err error) {
-	ret := CfdGetPubkeyFromExtkey(handle, extkey, networkType, &pubkey)
-	err = convertCfdError(ret, handle)
+func CfdGoGetPubkeyFromExtkey(handle uintptr, extkey string, networkType NetworkType) (pubkey string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		err = convertGoError(err, "CfdGoGetPubkeyFromExtkey")
+		return
+	}
+	extkey, _ = toNativeExtkey(extkey)
+	ret := CfdGetPubkeyFromExtkey(handle, extkey, int(getNativeNetworkType(networkType)), &pubkey)
+	err = convertCfdError(ret, handle, "CfdGoGetPubkeyFromExtkey")
 	return pubkey, err
 }
 
+/**
+ * Get extkey information.
+ * param: handle       cfd handle
+ * param: extkey       extkey
+ * return: data        extkey data
+ * return: err         error
+ */
+func CfdGoGetExtkeyInformation(handle uintptr, extkey string) (data CfdExtkeyData, err error) {
+	nativeExtkey, _ := toNativeExtkey(extkey)
+	var nativeVersion, fingerprint, chainCode string
+	var depth, childNumber uint32
+	depthPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&depth)))
+	childNumberPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&childNumber)))
+	ret := CfdGetExtkeyInformation(handle, nativeExtkey, &nativeVersion, &fingerprint, &chainCode, depthPtr, childNumberPtr)
+	if err = convertCfdError(ret, handle, "CfdGoGetExtkeyInformation"); err != nil {
+		return CfdExtkeyData{}, err
+	}
+
+	version, versionData, keyType, err := findExtkeyVersion(extkey)
+	if err != nil {
+		return CfdExtkeyData{}, convertGoError(err, "CfdGoGetExtkeyInformation")
+	}
+	var key string
+	nativeNetworkType := getNativeNetworkType(versionData.networkType)
+	if keyType == KCfdExtPrivkey {
+		var wif string
+		ret = CfdGetPrivkeyFromExtkey(handle, nativeExtkey, int(nativeNetworkType), &key, &wif)
+	} else {
+		ret = CfdGetPubkeyFromExtkey(handle, nativeExtkey, int(nativeNetworkType), &key)
+	}
+	if err = convertCfdError(ret, handle, "CfdGoGetExtkeyInformation"); err != nil {
+		return CfdExtkeyData{}, err
+	}
+	data = CfdExtkeyData{
+		Version:     fmt.Sprintf("%08x", version),
+		Fingerprint: fingerprint,
+		ChainCode:   chainCode,
+		Depth:       depth,
+		ChildNumber: childNumber,
+		KeyType:     keyType,
+		Network:     versionData.networkType,
+		HashType:    versionData.hashType,
+		Key:         key,
+	}
+	return data, nil
+}
+
+/**
+ * Create extkey from the key information.
+ * param: handle       cfd handle
+ * param: networkType  network type
+ * param: keyType      extkey type
+ * param: fingerprint  parent fingerprint hex (empty is zero)
+ * param: key          privkey hex (privkey type) or compressed pubkey hex (pubkey type)
+ * param: chainCode    chain code hex
+ * param: depth        depth
+ * param: childNumber  child number
+ * return: extkey      extkey
+ * return: err         error
+ */
+func CfdGoCreateExtkey(handle uintptr, networkType NetworkType, keyType ExtKeyType, fingerprint string, key string, chainCode string, depth byte, childNumber uint32) (extkey string, err error) {
+	if fingerprint == "" {
+		fingerprint = "00000000"
+	}
+	return createExtkey(handle, networkType, keyType, "", fingerprint, key, chainCode, depth, childNumber, "CfdGoCreateExtkey")
+}
+
+/**
+ * Create extkey from the parent key and the key information.
+ * param: handle       cfd handle
+ * param: networkType  network type
+ * param: keyType      extkey type
+ * param: parentKey    parent privkey hex or parent pubkey hex
+ * param: key          privkey hex (privkey type) or compressed pubkey hex (pubkey type)
+ * param: chainCode    chain code hex
+ * param: depth        depth
+ * param: childNumber  child number
+ * return: extkey      extkey
+ * return: err         error
+ */
+func CfdGoCreateExtkeyFromParent(handle uintptr, networkType NetworkType, keyType ExtKeyType, parentKey string, key string, chainCode string, depth byte, childNumber uint32) (extkey string, err error) {
+	if depth == 0 {
+		return "", convertGoError(errors.New("Invalid depth."), "CfdGoCreateExtkeyFromParent")
+	}
+	return createExtkey(handle, networkType, keyType, parentKey, "", key, chainCode, depth, childNumber, "CfdGoCreateExtkeyFromParent")
+}
+
+/**
+ * Create extkey by cfd.
+ * detail: fingerprint is calculated from parentKey if it is not empty.
+ * param: handle       cfd handle
+ * param: networkType  network type
+ * param: keyType      extkey type
+ * param: parentKey    parent privkey hex or parent pubkey hex
+ * param: fingerprint  parent fingerprint hex
+ * param: key          privkey hex (privkey type) or compressed pubkey hex (pubkey type)
+ * param: chainCode    chain code hex
+ * param: depth        depth
+ * param: childNumber  child number
+ * param: funcName     function name of the error
+ * return: extkey      extkey
+ * return: err         error
+ */
+func createExtkey(handle uintptr, networkType NetworkType, keyType ExtKeyType, parentKey string, fingerprint string, key string, chainCode string, depth byte, childNumber uint32, funcName string) (extkey string, err error) {
+	if err = validateEnumTypes(networkType, keyType); err != nil {
+		return "", convertGoError(err, funcName)
+	}
+	childNumberPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&childNumber)))
+	ret := CfdCreateExtkey(handle, int(getNativeNetworkType(networkType)), int(keyType), parentKey, fingerprint, key, chainCode, depth, childNumberPtr, &extkey)
+	if err = convertCfdError(ret, handle, funcName); err != nil {
+		return "", err
+	}
+	if extkey, err = fromNativeExtkey(extkey, networkType, KCfdP2pkh); err != nil {
+		return "", convertGoError(err, funcName)
+	}
+	return extkey, nil
+}
+
 /**
  * Parse script items from script.
  * param: handle          cfd handle.
@@ -2560,13 +5056,100 @@ func CfdGoParseScript(handle uintptr, script string) (scriptItems []string, err
 	}
 	
 	if ret != (int)(KCfdSuccess) {
-		err = convertCfdError(ret, handle)
+		err = convertCfdError(ret, handle, "CfdGoParseScript")
 		scriptItems = nil
 	}
 	return
 }
 
 
+/**
+ * Get mnemonic word list.
+ * param: handle       cfd handle
+ * param: language     language (en, jp)
+ * return: wordList    word list
+ * return: err         error
+ */
+func CfdGoGetMnemonicWordList(handle uintptr, language string) (wordList []string, err error) {
+	var mnemonicHandle uintptr
+	var maxIndex uint32
+	maxIndexPtr := SwigcptrUint32_t(uintptr(unsafe.Pointer(&maxIndex)))
+	var ret int
+
+	if ret = CfdInitializeMnemonicWordList(handle, language, &mnemonicHandle, maxIndexPtr); ret == (int)(KCfdSuccess) {
+		wordList = make([]string, 0, maxIndex)
+		for i := uint32(0); i < maxIndex; i++ {
+			var word string
+			index := SwigcptrUint32_t(uintptr(unsafe.Pointer(&i)))
+			if ret = CfdGetMnemonicWord(handle, mnemonicHandle, index, &word); ret != (int)(KCfdSuccess) {
+				break
+			}
+			wordList = append(wordList, word)
+		}
+
+		if freeRet := CfdFreeMnemonicWordList(handle, mnemonicHandle); ret == (int)(KCfdSuccess) {
+			ret = freeRet
+		}
+	}
+
+	if ret != (int)(KCfdSuccess) {
+		err = convertCfdError(ret, handle, "CfdGoGetMnemonicWordList")
+		wordList = nil
+	}
+	return
+}
+
+/**
+ * Convert entropy to mnemonic.
+ * param: handle       cfd handle
+ * param: entropy      entropy hex (16, 20, 24, 28 or 32 bytes)
+ * param: language     language (en, jp)
+ * return: mnemonic    mnemonic words
+ * return: err         error
+ */
+func CfdGoConvertEntropyToMnemonic(handle uintptr, entropy string, language string) (mnemonic []string, err error) {
+	var mnemonicSentence string
+	ret := CfdConvertEntropyToMnemonic(handle, entropy, language, &mnemonicSentence)
+	err = convertCfdError(ret, handle, "CfdGoConvertEntropyToMnemonic")
+	if err == nil {
+		mnemonic = SplitMnemonic(mnemonicSentence)
+	}
+	return mnemonic, err
+}
+
+/**
+ * Convert mnemonic to entropy. (word and checksum are validated)
+ * param: handle       cfd handle
+ * param: mnemonic     mnemonic words
+ * param: language     language (en, jp)
+ * return: entropy     entropy hex
+ * return: err         error
+ */
+func CfdGoConvertMnemonicToEntropy(handle uintptr, mnemonic []string, language string) (entropy string, err error) {
+	var seed string
+	ret := CfdConvertMnemonicToSeed(handle, JoinMnemonic(mnemonic, language), "", true, language, language == MnemonicLanguageJp, &seed, &entropy)
+	err = convertCfdError(ret, handle, "CfdGoConvertMnemonicToEntropy")
+	return entropy, err
+}
+
+/**
+ * Convert mnemonic to seed.
+ * detail: seed is usable by CfdGoCreateExtkeyFromSeed.
+ * param: handle       cfd handle
+ * param: mnemonic     mnemonic words
+ * param: passphrase   passphrase
+ * param: strictCheck  validate mnemonic words and checksum
+ * param: language     language (en, jp. use by strictCheck)
+ * return: seed        seed hex (64 bytes)
+ * return: entropy     entropy hex (empty if strictCheck is false)
+ * return: err         error
+ */
+func CfdGoConvertMnemonicToSeed(handle uintptr, mnemonic []string, passphrase string, strictCheck bool, language string) (seed string, entropy string, err error) {
+	ret := CfdConvertMnemonicToSeed(handle, JoinMnemonic(mnemonic, language), passphrase, strictCheck, language, language == MnemonicLanguageJp, &seed, &entropy)
+	err = convertCfdError(ret, handle, "CfdGoConvertMnemonicToSeed")
+	return seed, entropy, err
+}
+
 
 type SwigcptrUint64_t uintptr
 type Uint64_t interface {
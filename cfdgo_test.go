@@ -1,6 +1,7 @@
 package cfdgo
 
 import (
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -36,10 +37,11 @@ func TestCfdGetLastError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "", errMsg)
 
-	_, _, _, err = CfdGoCreateAddress(handle, 200, "", "", 200)
+	var address, lockingScript, segwitLockingScript string
+	ret := CfdCreateAddress(handle, 200, "", "", 200, &address, &lockingScript, &segwitLockingScript)
+	assert.Equal(t, (int)(KCfdIllegalArgumentError), ret)
 	lastErr = CfdGetLastErrorCode(handle)
 	assert.Equal(t, (int)(KCfdIllegalArgumentError), lastErr)
-	assert.Error(t, err)
 	errMsg, _ = CfdGoGetLastErrorMessage(handle)
 	assert.Equal(t, "Illegal network type.", errMsg)
 
@@ -48,6 +50,34 @@ func TestCfdGetLastError(t *testing.T) {
 	fmt.Print("TestCfdGetLastError test done.\n")
 }
 
+func TestCfdError(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	_, _, _, err = CfdGoCreateAddress(handle, 200, "", "", 200)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+	assert.False(t, errors.Is(err, ErrOutOfRange))
+	var cfdErr *CfdError
+	assert.True(t, errors.As(err, &cfdErr))
+	if cfdErr != nil {
+		assert.Equal(t, KCfdIllegalArgumentError, cfdErr.Code)
+		assert.Equal(t, "Illegal network type.", cfdErr.Message)
+		assert.Equal(t, "CfdGoCreateAddress", cfdErr.Operation)
+		assert.Equal(t, "CFD Error: message=[Illegal network type.], code=[1], operation=[CfdGoCreateAddress]", cfdErr.Error())
+	}
+
+	// without handle
+	err = convertCfdError((int)(KCfdOutOfRangeError), uintptr(0), "test")
+	assert.True(t, errors.Is(err, ErrOutOfRange))
+	assert.Equal(t, "CFD Error: message=[Out of range access occered.], code=[3], operation=[test]", err.Error())
+	assert.NoError(t, convertCfdError((int)(KCfdSuccess), uintptr(0), "test"))
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdError test done.\n")
+}
+
 func TestCfdGetSupportedFunction(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
@@ -65,8 +95,8 @@ func TestCfdGoCreateAddress(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
 
-	hashType := (int)(KCfdP2pkh)
-	networkType := (int)(KCfdNetworkLiquidv1)
+	hashType := KCfdP2pkh
+	networkType := KCfdNetworkLiquidv1
 	pubkey := "0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798"
 	address, lockingScript, segwitLockingScript, err := CfdGoCreateAddress(handle, hashType, pubkey, "", networkType)
 	assert.NoError(t, err)
@@ -78,7 +108,7 @@ func TestCfdGoCreateAddress(t *testing.T) {
 		fmt.Print("[error message] " + errStr + "\n")
 	}
 
-	hashType = (int)(KCfdP2sh)
+	hashType = KCfdP2sh
 	redeemScript := "210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac"
 	address, lockingScript, segwitLockingScript, err = CfdGoCreateAddress(
 		handle, hashType, "", redeemScript, networkType)
@@ -91,7 +121,7 @@ func TestCfdGoCreateAddress(t *testing.T) {
 		fmt.Print("[error message] " + errStr + "\n")
 	}
 
-	hashType = (int)(KCfdP2shP2wpkh)
+	hashType = KCfdP2shP2wpkh
 	pubkey = "0205ffcdde75f262d66ada3dd877c7471f8f8ee9ee24d917c3e18d01cee458bafe"
 	address, lockingScript, segwitLockingScript, err = CfdGoCreateAddress(
 		handle, hashType, pubkey, "", networkType)
@@ -104,8 +134,8 @@ func TestCfdGoCreateAddress(t *testing.T) {
 		fmt.Print("[error message] " + errStr + "\n")
 	}
 
-	hashType = (int)(KCfdP2wpkh)
-	networkType = (int)(KCfdNetworkElementsRegtest)
+	hashType = KCfdP2wpkh
+	networkType = KCfdNetworkElementsRegtest
 	pubkey = "02bedf98a38247c1718fdff7e07561b4dc15f10323ebb0accab581778e72c2e995"
 	address, lockingScript, segwitLockingScript, err = CfdGoCreateAddress(
 		handle, hashType, pubkey, "", networkType)
@@ -127,8 +157,8 @@ func TestCfdGoCreateMultisigScript(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
 
-	networkType := (int)(KCfdNetworkLiquidv1)
-	hashType := (int)(KCfdP2shP2wsh)
+	networkType := KCfdNetworkLiquidv1
+	hashType := KCfdP2shP2wsh
 	pubkeys := []string{"0205ffcdde75f262d66ada3dd877c7471f8f8ee9ee24d917c3e18d01cee458bafe", "02be61f4350b4ae7544f99649a917f48ba16cf48c983ac1599774958d88ad17ec5"}
 	address, redeemScript, witnessScript, err := CfdGoCreateMultisigScript(handle, networkType, hashType, pubkeys, uint32(2))
 	assert.NoError(t, err)
@@ -149,8 +179,8 @@ func TestCfdGoGetAddressesFromMultisig(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
 
-	networkType := (int)(KCfdNetworkLiquidv1)
-	hashType := (int)(KCfdP2shP2wpkh)
+	networkType := KCfdNetworkLiquidv1
+	hashType := KCfdP2shP2wpkh
 	redeemScript := "52210205ffcdde75f262d66ada3dd877c7471f8f8ee9ee24d917c3e18d01cee458bafe2102be61f4350b4ae7544f99649a917f48ba16cf48c983ac1599774958d88ad17ec552ae"
 	addressList, pubkeyList, err := CfdGoGetAddressesFromMultisig(handle, redeemScript, networkType, hashType)
 	assert.NoError(t, err)
@@ -179,7 +209,7 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	assert.NoError(t, err)
 
 	// PKH
-	networkType := (int)(KCfdNetworkLiquidv1)
+	networkType := KCfdNetworkLiquidv1
 	descriptorDataList, multisigList, err := CfdGoParseDescriptor(handle,
 		"pkh(02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5)",
 		networkType,
@@ -188,17 +218,17 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	assert.Equal(t, 1, len(descriptorDataList))
 	assert.Equal(t, 0, len(multisigList))
 	if len(descriptorDataList) == 1 {
-		assert.Equal(t, uint32(0), descriptorDataList[0].depth)
-		assert.Equal(t, (int)(KCfdDescriptorScriptPkh), descriptorDataList[0].scriptType)
-		assert.Equal(t, "76a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac", descriptorDataList[0].lockingScript)
-		assert.Equal(t, "PwsjpD1YkjcfZ95WGVZuvGfypkKmpogoA3", descriptorDataList[0].address)
-		assert.Equal(t, (int)(KCfdP2pkh), descriptorDataList[0].hashType)
-		assert.Equal(t, "", descriptorDataList[0].redeemScript)
-		assert.Equal(t, (int)(KCfdDescriptorKeyPublic), descriptorDataList[0].keyType)
-		assert.Equal(t, "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5", descriptorDataList[0].pubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPrivkey)
-		assert.Equal(t, false, descriptorDataList[0].isMultisig)
+		assert.Equal(t, uint32(0), descriptorDataList[0].Depth)
+		assert.Equal(t, KCfdDescriptorScriptPkh, descriptorDataList[0].ScriptType)
+		assert.Equal(t, "76a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac", descriptorDataList[0].LockingScript)
+		assert.Equal(t, "PwsjpD1YkjcfZ95WGVZuvGfypkKmpogoA3", descriptorDataList[0].Address)
+		assert.Equal(t, KCfdP2pkh, descriptorDataList[0].HashType)
+		assert.Equal(t, "", descriptorDataList[0].RedeemScript)
+		assert.Equal(t, KCfdDescriptorKeyPublic, descriptorDataList[0].KeyType)
+		assert.Equal(t, "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5", descriptorDataList[0].Pubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPrivkey)
+		assert.Equal(t, false, descriptorDataList[0].IsMultisig)
 	}
 	if err != nil {
 		errMsg, _ := CfdGoGetLastErrorMessage(handle)
@@ -206,7 +236,7 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	}
 
 	// p2sh-p2wsh(pkh)
-	networkType = (int)(KCfdNetworkLiquidv1)
+	networkType = KCfdNetworkLiquidv1
 	descriptorDataList, multisigList, err = CfdGoParseDescriptor(handle,
 		"sh(wsh(pkh(02e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13)))",
 		networkType, "")
@@ -215,41 +245,41 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	assert.Equal(t, 0, len(multisigList))
 	if len(descriptorDataList) == 3 {
 		// 0
-		assert.Equal(t, uint32(0), descriptorDataList[0].depth)
-		assert.Equal(t, (int)(KCfdDescriptorScriptSh), descriptorDataList[0].scriptType)
-		assert.Equal(t, "a91455e8d5e8ee4f3604aba23c71c2684fa0a56a3a1287", descriptorDataList[0].lockingScript)
-		assert.Equal(t, "Gq1mmExLuSEwfzzk6YtUxJ769grv6T5Tak", descriptorDataList[0].address)
-		assert.Equal(t, (int)(KCfdP2shP2wsh), descriptorDataList[0].hashType)
-		assert.Equal(t, "0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", descriptorDataList[0].redeemScript)
-		assert.Equal(t, (int)(KCfdDescriptorKeyNull), descriptorDataList[0].keyType)
-		assert.Equal(t, "", descriptorDataList[0].pubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPrivkey)
-		assert.Equal(t, false, descriptorDataList[0].isMultisig)
+		assert.Equal(t, uint32(0), descriptorDataList[0].Depth)
+		assert.Equal(t, KCfdDescriptorScriptSh, descriptorDataList[0].ScriptType)
+		assert.Equal(t, "a91455e8d5e8ee4f3604aba23c71c2684fa0a56a3a1287", descriptorDataList[0].LockingScript)
+		assert.Equal(t, "Gq1mmExLuSEwfzzk6YtUxJ769grv6T5Tak", descriptorDataList[0].Address)
+		assert.Equal(t, KCfdP2shP2wsh, descriptorDataList[0].HashType)
+		assert.Equal(t, "0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", descriptorDataList[0].RedeemScript)
+		assert.Equal(t, KCfdDescriptorKeyNull, descriptorDataList[0].KeyType)
+		assert.Equal(t, "", descriptorDataList[0].Pubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPrivkey)
+		assert.Equal(t, false, descriptorDataList[0].IsMultisig)
 		// 1
-		assert.Equal(t, uint32(1), descriptorDataList[1].depth)
-		assert.Equal(t, (int)(KCfdDescriptorScriptWsh), descriptorDataList[1].scriptType)
-		assert.Equal(t, "0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", descriptorDataList[1].lockingScript)
-		assert.Equal(t, "ex1ql3dvcvp24wtlsg0e5c0pe3tju7tg5cp428546jap9dga7evpfqhs0htdlf", descriptorDataList[1].address)
-		assert.Equal(t, (int)(KCfdP2wsh), descriptorDataList[1].hashType)
-		assert.Equal(t, "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", descriptorDataList[1].redeemScript)
-		assert.Equal(t, (int)(KCfdDescriptorKeyNull), descriptorDataList[1].keyType)
-		assert.Equal(t, "", descriptorDataList[1].pubkey)
-		assert.Equal(t, "", descriptorDataList[1].extPubkey)
-		assert.Equal(t, "", descriptorDataList[1].extPrivkey)
-		assert.Equal(t, false, descriptorDataList[1].isMultisig)
+		assert.Equal(t, uint32(1), descriptorDataList[1].Depth)
+		assert.Equal(t, KCfdDescriptorScriptWsh, descriptorDataList[1].ScriptType)
+		assert.Equal(t, "0020fc5acc302aab97f821f9a61e1cc572e7968a603551e95d4ba12b51df6581482f", descriptorDataList[1].LockingScript)
+		assert.Equal(t, "ex1ql3dvcvp24wtlsg0e5c0pe3tju7tg5cp428546jap9dga7evpfqhs0htdlf", descriptorDataList[1].Address)
+		assert.Equal(t, KCfdP2wsh, descriptorDataList[1].HashType)
+		assert.Equal(t, "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", descriptorDataList[1].RedeemScript)
+		assert.Equal(t, KCfdDescriptorKeyNull, descriptorDataList[1].KeyType)
+		assert.Equal(t, "", descriptorDataList[1].Pubkey)
+		assert.Equal(t, "", descriptorDataList[1].ExtPubkey)
+		assert.Equal(t, "", descriptorDataList[1].ExtPrivkey)
+		assert.Equal(t, false, descriptorDataList[1].IsMultisig)
 		// 2
-		assert.Equal(t, uint32(2), descriptorDataList[2].depth)
-		assert.Equal(t, (int)(KCfdDescriptorScriptPkh), descriptorDataList[2].scriptType)
-		assert.Equal(t, "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", descriptorDataList[2].lockingScript)
-		assert.Equal(t, "QF9hGPQMVAPc8RxTHALgSvNPWEjGbL9bse", descriptorDataList[2].address)
-		assert.Equal(t, (int)(KCfdP2pkh), descriptorDataList[2].hashType)
-		assert.Equal(t, "", descriptorDataList[2].redeemScript)
-		assert.Equal(t, (int)(KCfdDescriptorKeyPublic), descriptorDataList[2].keyType)
-		assert.Equal(t, "02e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13", descriptorDataList[2].pubkey)
-		assert.Equal(t, "", descriptorDataList[2].extPubkey)
-		assert.Equal(t, "", descriptorDataList[2].extPrivkey)
-		assert.Equal(t, false, descriptorDataList[2].isMultisig)
+		assert.Equal(t, uint32(2), descriptorDataList[2].Depth)
+		assert.Equal(t, KCfdDescriptorScriptPkh, descriptorDataList[2].ScriptType)
+		assert.Equal(t, "76a914c42e7ef92fdb603af844d064faad95db9bcdfd3d88ac", descriptorDataList[2].LockingScript)
+		assert.Equal(t, "QF9hGPQMVAPc8RxTHALgSvNPWEjGbL9bse", descriptorDataList[2].Address)
+		assert.Equal(t, KCfdP2pkh, descriptorDataList[2].HashType)
+		assert.Equal(t, "", descriptorDataList[2].RedeemScript)
+		assert.Equal(t, KCfdDescriptorKeyPublic, descriptorDataList[2].KeyType)
+		assert.Equal(t, "02e493dbf1c10d80f3581e4904930b1404cc6c13900ee0758474fa94abe8c4cd13", descriptorDataList[2].Pubkey)
+		assert.Equal(t, "", descriptorDataList[2].ExtPubkey)
+		assert.Equal(t, "", descriptorDataList[2].ExtPrivkey)
+		assert.Equal(t, false, descriptorDataList[2].IsMultisig)
 	}
 	if err != nil {
 		errMsg, _ := CfdGoGetLastErrorMessage(handle)
@@ -257,7 +287,7 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	}
 
 	// multisig (bitcoin)
-	networkType = (int)(KCfdNetworkMainnet)
+	networkType = KCfdNetworkMainnet
 	descriptorDataList, multisigList, err = CfdGoParseDescriptor(handle,
 		"wsh(multi(1,xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*,xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*))",
 		networkType,
@@ -266,27 +296,27 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	assert.Equal(t, 1, len(descriptorDataList))
 	assert.Equal(t, 2, len(multisigList))
 	if len(descriptorDataList) == 1 {
-		assert.Equal(t, uint32(0), descriptorDataList[0].depth)
-		assert.Equal(t, (int)(KCfdDescriptorScriptWsh), descriptorDataList[0].scriptType)
-		assert.Equal(t, "002064969d8cdca2aa0bb72cfe88427612878db98a5f07f9a7ec6ec87b85e9f9208b", descriptorDataList[0].lockingScript)
-		assert.Equal(t, "bc1qvjtfmrxu524qhdevl6yyyasjs7xmnzjlqlu60mrwepact60eyz9s9xjw0c", descriptorDataList[0].address)
-		assert.Equal(t, (int)(KCfdP2wsh), descriptorDataList[0].hashType)
-		assert.Equal(t, "51210205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed871062102c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e7514477452ae", descriptorDataList[0].redeemScript)
-		assert.Equal(t, (int)(KCfdDescriptorKeyNull), descriptorDataList[0].keyType)
-		assert.Equal(t, "", descriptorDataList[0].pubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPubkey)
-		assert.Equal(t, "", descriptorDataList[0].extPrivkey)
-		assert.Equal(t, true, descriptorDataList[0].isMultisig)
+		assert.Equal(t, uint32(0), descriptorDataList[0].Depth)
+		assert.Equal(t, KCfdDescriptorScriptWsh, descriptorDataList[0].ScriptType)
+		assert.Equal(t, "002064969d8cdca2aa0bb72cfe88427612878db98a5f07f9a7ec6ec87b85e9f9208b", descriptorDataList[0].LockingScript)
+		assert.Equal(t, "bc1qvjtfmrxu524qhdevl6yyyasjs7xmnzjlqlu60mrwepact60eyz9s9xjw0c", descriptorDataList[0].Address)
+		assert.Equal(t, KCfdP2wsh, descriptorDataList[0].HashType)
+		assert.Equal(t, "51210205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed871062102c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e7514477452ae", descriptorDataList[0].RedeemScript)
+		assert.Equal(t, KCfdDescriptorKeyNull, descriptorDataList[0].KeyType)
+		assert.Equal(t, "", descriptorDataList[0].Pubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPubkey)
+		assert.Equal(t, "", descriptorDataList[0].ExtPrivkey)
+		assert.Equal(t, true, descriptorDataList[0].IsMultisig)
 	}
 	if len(multisigList) == 2 {
-		assert.Equal(t, (int)(KCfdDescriptorKeyBip32), multisigList[0].keyType)
-		assert.Equal(t, "0205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed87106", multisigList[0].pubkey)
-		assert.Equal(t, "xpub6BgWskLoyHmAUeKWgUXCGfDdCMRXseEjRCMEMvjkedmHpnvWtpXMaCRm8qcADw9einPR8o2c49ZpeHRZP4uYwGeMU2T63G7uf2Y1qJavrWQ", multisigList[0].extPubkey)
-		assert.Equal(t, "", multisigList[0].extPrivkey)
-		assert.Equal(t, (int)(KCfdDescriptorKeyBip32), multisigList[1].keyType)
-		assert.Equal(t, "02c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e75144774", multisigList[1].pubkey)
-		assert.Equal(t, "xpub6EKMC2gSMfKgQJ3iNMZVNB4GLH1Dc4hNPah1iMbbztxdUPRo84MMcTgkPATWNRyzr7WifKrt5VvQi4GEqRwybCP1LHoXBKLN6cB15HuBKPE", multisigList[1].extPubkey)
-		assert.Equal(t, "", multisigList[1].extPrivkey)
+		assert.Equal(t, KCfdDescriptorKeyBip32, multisigList[0].KeyType)
+		assert.Equal(t, "0205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed87106", multisigList[0].Pubkey)
+		assert.Equal(t, "xpub6BgWskLoyHmAUeKWgUXCGfDdCMRXseEjRCMEMvjkedmHpnvWtpXMaCRm8qcADw9einPR8o2c49ZpeHRZP4uYwGeMU2T63G7uf2Y1qJavrWQ", multisigList[0].ExtPubkey)
+		assert.Equal(t, "", multisigList[0].ExtPrivkey)
+		assert.Equal(t, KCfdDescriptorKeyBip32, multisigList[1].KeyType)
+		assert.Equal(t, "02c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e75144774", multisigList[1].Pubkey)
+		assert.Equal(t, "xpub6EKMC2gSMfKgQJ3iNMZVNB4GLH1Dc4hNPah1iMbbztxdUPRo84MMcTgkPATWNRyzr7WifKrt5VvQi4GEqRwybCP1LHoXBKLN6cB15HuBKPE", multisigList[1].ExtPubkey)
+		assert.Equal(t, "", multisigList[1].ExtPrivkey)
 	}
 	if err != nil {
 		errMsg, _ := CfdGoGetLastErrorMessage(handle)
@@ -298,6 +328,44 @@ func TestCfdGoParseDescriptor(t *testing.T) {
 	fmt.Print("TestCfdGoParseDescriptor test done.\n")
 }
 
+func TestCfdGoDeriveDescriptorRange(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	descriptor := "wsh(multi(1,xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*,xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*))"
+	rangeDataList, err := CfdGoDeriveDescriptorRange(handle, descriptor, KCfdNetworkMainnet, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(rangeDataList))
+	if len(rangeDataList) == 3 {
+		assert.Equal(t, uint32(0), rangeDataList[0].Index)
+		assert.Equal(t, "002064969d8cdca2aa0bb72cfe88427612878db98a5f07f9a7ec6ec87b85e9f9208b", rangeDataList[0].LockingScript)
+		assert.Equal(t, "bc1qvjtfmrxu524qhdevl6yyyasjs7xmnzjlqlu60mrwepact60eyz9s9xjw0c", rangeDataList[0].Address)
+		assert.Equal(t, uint32(1), rangeDataList[1].Index)
+		assert.Equal(t, "00200e869c7fb066dc4879de9560c4615b1f2402efd1852170bab9f14b7759b807f1", rangeDataList[1].LockingScript)
+		assert.Equal(t, "bc1qp6rfclasvmwys7w7j4svgc2mrujq9m73s5shpw4e799hwkdcqlcsj464fw", rangeDataList[1].Address)
+		assert.Equal(t, uint32(2), rangeDataList[2].Index)
+		assert.Equal(t, "0020827e61124a4ab2c85e01f96749fab483b0c852de0d167035cea72660c9cd63a8", rangeDataList[2].LockingScript)
+		assert.Equal(t, "bc1qsflxzyj2f2evshspl9n5n745swcvs5k7p5t8qdww5unxpjwdvw5qx53ms4", rangeDataList[2].Address)
+	}
+
+	rangeDataList, err = CfdGoDeriveDescriptorRange(handle, descriptor, KCfdNetworkMainnet, 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rangeDataList))
+	if len(rangeDataList) == 1 {
+		assert.Equal(t, uint32(2), rangeDataList[0].Index)
+		assert.Equal(t, "bc1qsflxzyj2f2evshspl9n5n745swcvs5k7p5t8qdww5unxpjwdvw5qx53ms4", rangeDataList[0].Address)
+	}
+
+	_, err = CfdGoDeriveDescriptorRange(handle, descriptor, KCfdNetworkMainnet, 3, 2)
+	assert.Error(t, err)
+	_, err = CfdGoDeriveDescriptorRange(handle, descriptor, KCfdNetworkMainnet, 0, 0x80000000)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGoDeriveDescriptorRange test done.\n")
+}
+
 func TestCfdCreateRawTransaction(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
@@ -599,14 +667,14 @@ func TestCfdAddSignConfidentialTx(t *testing.T) {
 
 	pubkey := "03f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6"
 	privkey := "cU4KjNUT7GjHm7CkjRjG46SzLrXHXoH3ekXmqa2jTCFPMkQ64sw1"
-	privkeyWifNetworkType := (int)(KCfdNetworkRegtest)
+	privkeyWifNetworkType := KCfdNetworkRegtest
 	txid := "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f"
 	vout := uint32(0)
 	txHex := ""
-	sigHashType := (int)(KCfdSigHashAll)
-	hashType := (int)(KCfdP2wpkh)
+	sigHashType := KCfdSigHashAll
+	hashType := KCfdP2wpkh
 	isWitness := true
-	if (hashType == (int)(KCfdP2pkh)) || (hashType == (int)(KCfdP2sh)) {
+	if (hashType == KCfdP2pkh) || (hashType == KCfdP2sh) {
 		isWitness = false
 	}
 
@@ -658,14 +726,14 @@ func TestCfdAddSignConfidentialTxPkh(t *testing.T) {
 
 	pubkey := "03f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6"
 	privkey := "cU4KjNUT7GjHm7CkjRjG46SzLrXHXoH3ekXmqa2jTCFPMkQ64sw1"
-	privkeyWifNetworkType := (int)(KCfdNetworkRegtest)
+	privkeyWifNetworkType := KCfdNetworkRegtest
 	txid := "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f"
 	vout := uint32(0)
 	txHex := ""
-	sigHashType := (int)(KCfdSigHashAll)
-	hashType := (int)(KCfdP2pkh)
+	sigHashType := KCfdSigHashAll
+	hashType := KCfdP2pkh
 	isWitness := true
-	if (hashType == (int)(KCfdP2pkh)) || (hashType == (int)(KCfdP2sh)) {
+	if (hashType == KCfdP2pkh) || (hashType == KCfdP2sh) {
 		isWitness = false
 	}
 
@@ -709,9 +777,9 @@ func TestCfdAddMultisigSignConfidentialTx(t *testing.T) {
 	privkey1 := "cRVLMWHogUo51WECRykTbeLNbm5c57iEpSegjdxco3oef6o5dbFi"
 	pubkey2 := "02bfd7daa5d113fcbd8c2f374ae58cbb89cbed9570e898f1af5ff989457e2d4d71"
 	privkey2 := "cQUTZ8VbWNYBEtrB7xwe41kqiKMQPRZshTvBHmkoJGaUfmS5pxzR"
-	networkType := (int)(KCfdNetworkRegtest)
-	sigHashType := (int)(KCfdSigHashAll)
-	hashType := (int)(KCfdP2sh)
+	networkType := KCfdNetworkRegtest
+	sigHashType := KCfdSigHashAll
+	hashType := KCfdP2sh
 
 	// create multisig address
 	pubkeys := []string{pubkey2, pubkey1}
@@ -776,7 +844,7 @@ func TestCfdConfidentialAddress(t *testing.T) {
 	kAddress := "Q7wegLt2qMGhm28vch6VTzvpzs8KXvs4X7"
 	kConfidentialKey := "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357"
 	kConfidentialAddr := "VTpvKKc1SNmLG4H8CnR1fGJdHdyWGEQEvdP9gfeneJR7n81S5kiwNtgF7vrZjC8mp63HvwxM81nEbTxU"
-	kNetworkType := (int)(KCfdNetworkLiquidv1)
+	kNetworkType := KCfdNetworkLiquidv1
 
 	confidentialAddr, err := CfdGoCreateConfidentialAddress(handle, kAddress, kConfidentialKey)
 	assert.NoError(t, err)
@@ -805,7 +873,7 @@ func TestCfdCalculateEcSignature(t *testing.T) {
 	kSighash := "9b169f5af064cc2a0dac08d8be3c9e8bc3d3e1a3f3e2a44f0c3e4ecf23d56cf2"
 	kPrivkey := "cU4KjNUT7GjHm7CkjRjG46SzLrXHXoH3ekXmqa2jTCFPMkQ64sw1"
 	kExtSignature := "0bc7f08a2a8a5446e7483db1b46184ba3cc79d78a3452a72c5bc712cc7efb51f58af044d646c1fd4f755d49db26faa203937bc66c569047a7d3d3da531826060"
-	kNetwork := (int)(KCfdNetworkRegtest)
+	kNetwork := KCfdNetworkRegtest
 
 	signature, err := CfdGoCalculateEcSignature(handle, kSighash, "", kPrivkey, kNetwork, true)
 	assert.NoError(t, err)
@@ -825,7 +893,7 @@ func TestCfdPrivkeyAndPubkey(t *testing.T) {
 	handle, err := CfdGoCreateHandle()
 	assert.NoError(t, err)
 
-	kNetwork := (int)(KCfdNetworkRegtest)
+	kNetwork := KCfdNetworkRegtest
 
 	// compress
 	pubkey, privkey, wif, err := CfdGoCreateKeyPair(handle, true, kNetwork)
@@ -873,13 +941,13 @@ func TestCfdExtkey(t *testing.T) {
 	assert.NoError(t, err)
 
 	kSeed := "0e09fbdd00e575b654d480ae979f24da45ef4dee645c7dc2e3b30b2e093d38dda0202357754cc856f8920b8e31dd02e9d34f6a2b20dc825c6ba90f90009085e1"
-	kNetwork := (int)(KCfdNetworkMainnet)
+	kNetwork := KCfdNetworkMainnet
 
-	extprivkey1, err := CfdGoCreateExtkeyFromSeed(handle, kSeed, kNetwork, (int)(KCfdExtPrivkey))
+	extprivkey1, err := CfdGoCreateExtkeyFromSeed(handle, kSeed, kNetwork, KCfdExtPrivkey)
 	assert.NoError(t, err)
 	assert.Equal(t, "xprv9s21ZrQH143K38XAstQ4D3hCGbgydJgNff6CcwmkrWTBxksb2G4CsqAywJCKbTdywfCpmpJyxqf77iKK1ju1J982iP2PriifaNZLMbyPQCx", extprivkey1)
 
-	extprivkey2, err := CfdGoCreateExtkeyFromParentPath(handle, extprivkey1, "m/44'", kNetwork, (int)(KCfdExtPrivkey))
+	extprivkey2, err := CfdGoCreateExtkeyFromParentPath(handle, extprivkey1, "m/44'", kNetwork, KCfdExtPrivkey)
 	assert.NoError(t, err)
 	assert.Equal(t, "xprv9tviYANkXM1CY831VtMFKFn6LP6aMHf1kvtCZyTL9YbyMwTR2BSmJaEoqw59BZdQhLSx9ZxyKsRUeCetxA2xZ34eupBqZUsifnWyLJJ16j3", extprivkey2)
 
@@ -887,7 +955,7 @@ func TestCfdExtkey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "xpub67v4wfueMiZVkc7UbutFgPiptQw4kkNs89ooNMrwht8xEjnZZim1rNZHhEdrLejB99fiBdnWNNAB8hmUK7tCo5Ua6UtHzwVLj2Bzpch7vB2", extpubkey1)
 
-	extprivkey3, err := CfdGoCreateExtkeyFromParentPath(handle, extprivkey2, "0h/0h/2", kNetwork, (int)(KCfdExtPrivkey))
+	extprivkey3, err := CfdGoCreateExtkeyFromParentPath(handle, extprivkey2, "0h/0h/2", kNetwork, KCfdExtPrivkey)
 	assert.NoError(t, err)
 	assert.Equal(t, "xprvA1YYKkMiZaDHRY4dmXjcP3js7ATJQAwt9gozTvi69etziyBAAENQN4w7sS3uBaF7rgXvP3sUtKFju7p3PosjNkRDuqqSFfxTjjEhgx6ejVZ", extprivkey3)
 
@@ -0,0 +1,527 @@
+package cfdgo
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// empty 32 byte hash (asset blinding nonce of new issuance, coinbase txid)
+const emptyHash256 = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// confidential commitment hex sizes
+const (
+	confidentialAssetHexSize = 66
+	confidentialValueHexSize = 66
+)
+
+/**
+ * Get issuance asset by cfd.
+ * detail: the issuance is set to a scratch transaction that has only the outpoint,
+ *         to use the asset calculation of cfd. the issued amount is sent to OP_RETURN.
+ * param: handle           cfd handle
+ * param: txid             outpoint txid
+ * param: vout             outpoint vout
+ * param: blindingNonce    asset blinding nonce (all zero if issuance)
+ * param: entropy          contract hash (issuance), asset entropy (reissuance)
+ * param: isBlindIssuance  blind issuance flag (for calculate token)
+ * return: assetEntropy    asset entropy
+ * return: asset           issued asset
+ * return: token           reissuance token (issuance only)
+ * return: err             error
+ */
+func getIssuanceAsset(handle uintptr, txid string, vout uint32, blindingNonce string, entropy string, isBlindIssuance bool) (assetEntropy string, asset string, token string, err error) {
+	txHex, err := CfdGoInitializeConfidentialTx(handle, uint32(2), uint32(0))
+	if err != nil {
+		return "", "", "", err
+	}
+	txHex, err = CfdGoAddConfidentialTxIn(handle, txHex, txid, vout, uint32(0xffffffff))
+	if err != nil {
+		return "", "", "", err
+	}
+	burnScript := hex.EncodeToString([]byte{opReturn})
+	if blindingNonce == emptyHash256 {
+		assetEntropy, asset, token, _, err = CfdGoSetRawIssueAsset(handle, txHex, txid, vout, entropy, int64(1), "", burnScript, int64(1), "", burnScript, isBlindIssuance)
+		return assetEntropy, asset, token, err
+	}
+	asset, _, err = CfdGoSetRawReissueAsset(handle, txHex, txid, vout, int64(1), blindingNonce, entropy, "", burnScript)
+	return entropy, asset, "", err
+}
+
+/**
+ * Convert satoshi amount to bitcoin amount.
+ * param: amount       satoshi amount
+ * return: btcAmount   bitcoin amount
+ */
+func convertSatoshiToBitcoin(amount int64) float64 {
+	return float64(amount) / 100000000
+}
+
+/**
+ * Get rangeproof information. (same as secp256k1_rangeproof_info)
+ * param: proof        rangeproof
+ * return: exponent    exponent
+ * return: mantissa    mantissa (bits)
+ * return: minValue    minimum value
+ * return: maxValue    maximum value
+ * return: ok          valid rangeproof header flag
+ */
+func getRangeproofInfo(proof []byte) (exponent int, mantissa int, minValue uint64, maxValue uint64, ok bool) {
+	if len(proof) < 65 || proof[0]&0x80 != 0 {
+		return 0, 0, 0, 0, false
+	}
+	offset := 0
+	hasNonzeroRange := proof[offset]&0x40 != 0
+	hasMin := proof[offset]&0x20 != 0
+	exponent = -1
+	if hasNonzeroRange {
+		exponent = int(proof[offset] & 0x1f)
+		offset++
+		if exponent > 18 {
+			return 0, 0, 0, 0, false
+		}
+		mantissa = int(proof[offset]) + 1
+		if mantissa > 64 {
+			return 0, 0, 0, 0, false
+		}
+		maxValue = ^uint64(0) >> uint(64-mantissa)
+	}
+	offset++
+	for i := 0; i < exponent; i++ {
+		if maxValue > ^uint64(0)/10 {
+			return 0, 0, 0, 0, false
+		}
+		maxValue *= 10
+	}
+	if hasMin {
+		if len(proof)-offset < 8 {
+			return 0, 0, 0, 0, false
+		}
+		minValue = binary.BigEndian.Uint64(proof[offset:])
+	}
+	if maxValue > ^uint64(0)-minValue {
+		return 0, 0, 0, 0, false
+	}
+	maxValue += minValue
+	return exponent, mantissa, minValue, maxValue, true
+}
+
+/**
+ * Decoded script sig struct.
+ */
+type CfdDecodedScriptSig struct {
+	// script asm
+	Asm string `json:"asm"`
+	// script hex
+	Hex string `json:"hex"`
+}
+
+/**
+ * Decoded locking script struct.
+ */
+type CfdDecodedScriptPubKey struct {
+	// script asm
+	Asm string `json:"asm"`
+	// script hex
+	Hex string `json:"hex"`
+	// require signature num (0 if no address)
+	ReqSigs int `json:"reqSigs,omitempty"`
+	// script type (pubkeyhash, scripthash, witness_v0_keyhash, multisig, fee, ...)
+	Type string `json:"type"`
+	// address list
+	Addresses []string `json:"addresses,omitempty"`
+	// pegout mainchain genesis block hash (pegout only)
+	PegoutChain string `json:"pegout_chain,omitempty"`
+	// pegout mainchain script asm (pegout only)
+	PegoutAsm string `json:"pegout_asm,omitempty"`
+	// pegout mainchain script hex (pegout only)
+	PegoutHex string `json:"pegout_hex,omitempty"`
+	// pegout require signature num (pegout only)
+	PegoutReqSigs int `json:"pegout_reqSigs,omitempty"`
+	// pegout mainchain script type (pegout only)
+	PegoutType string `json:"pegout_type,omitempty"`
+	// pegout mainchain address list (pegout only)
+	PegoutAddresses []string `json:"pegout_addresses,omitempty"`
+}
+
+/**
+ * Decoded issuance struct.
+ */
+type CfdDecodedIssuance struct {
+	// asset blinding nonce
+	AssetBlindingNonce string `json:"assetBlindingNonce"`
+	// asset entropy
+	AssetEntropy string `json:"assetEntropy"`
+	// reissuance flag
+	IsReissuance bool `json:"isreissuance"`
+	// token asset (issuance only)
+	Token string `json:"token,omitempty"`
+	// asset
+	Asset string `json:"asset"`
+	// asset amount (explicit only)
+	AssetAmount *float64 `json:"assetamount,omitempty"`
+	// asset amount commitment (blinded only)
+	AssetAmountCommitment string `json:"assetamountcommitment,omitempty"`
+	// token amount (explicit only)
+	TokenAmount *float64 `json:"tokenamount,omitempty"`
+	// token amount commitment (blinded only)
+	TokenAmountCommitment string `json:"tokenamountcommitment,omitempty"`
+}
+
+/**
+ * Decoded confidential transaction input struct.
+ */
+type CfdDecodedConfidentialTxIn struct {
+	// coinbase script (coinbase only)
+	Coinbase string `json:"coinbase,omitempty"`
+	// utxo txid
+	Txid string `json:"txid"`
+	// utxo vout
+	Vout uint32 `json:"vout"`
+	// script sig
+	ScriptSig CfdDecodedScriptSig `json:"scriptSig"`
+	// pegin flag
+	IsPegin bool `json:"is_pegin"`
+	// sequence number
+	Sequence uint32 `json:"sequence"`
+	// witness stack
+	TxinWitness []string `json:"txinwitness,omitempty"`
+	// pegin witness stack
+	PeginWitness []string `json:"pegin_witness,omitempty"`
+	// issuance (nil if not issuance)
+	Issuance *CfdDecodedIssuance `json:"issuance,omitempty"`
+}
+
+/**
+ * Marshal json. (coinbase input omits outpoint fields.)
+ */
+func (txin CfdDecodedConfidentialTxIn) MarshalJSON() ([]byte, error) {
+	type decodedTxIn CfdDecodedConfidentialTxIn
+	if txin.Coinbase == "" {
+		return json.Marshal(decodedTxIn(txin))
+	}
+	return json.Marshal(struct {
+		Coinbase     string   `json:"coinbase"`
+		Sequence     uint32   `json:"sequence"`
+		TxinWitness  []string `json:"txinwitness,omitempty"`
+		PeginWitness []string `json:"pegin_witness,omitempty"`
+	}{txin.Coinbase, txin.Sequence, txin.TxinWitness, txin.PeginWitness})
+}
+
+/**
+ * Decoded confidential transaction output struct.
+ */
+type CfdDecodedConfidentialTxOut struct {
+	// amount (explicit value or exact blinded value)
+	Value *float64 `json:"value,omitempty"`
+	// minimum amount (blinded only)
+	ValueMinimum *float64 `json:"value-minimum,omitempty"`
+	// maximum amount (blinded only)
+	ValueMaximum *float64 `json:"value-maximum,omitempty"`
+	// rangeproof exponent (blinded only)
+	CtExponent *int `json:"ct-exponent,omitempty"`
+	// rangeproof mantissa bits (blinded only)
+	CtBits *int `json:"ct-bits,omitempty"`
+	// surjection proof (blinded only)
+	SurjectionProof string `json:"surjectionproof,omitempty"`
+	// value commitment (blinded only)
+	ValueCommitment string `json:"valuecommitment,omitempty"`
+	// asset (explicit only)
+	Asset string `json:"asset,omitempty"`
+	// asset commitment (blinded only)
+	AssetCommitment string `json:"assetcommitment,omitempty"`
+	// nonce
+	CommitmentNonce string `json:"commitmentnonce"`
+	// nonce is valid pubkey
+	CommitmentNonceFullyValid bool `json:"commitmentnonce_fully_valid"`
+	// txout index
+	N uint32 `json:"n"`
+	// locking script
+	ScriptPubKey CfdDecodedScriptPubKey `json:"scriptPubKey"`
+	// rangeproof (not included in json)
+	Rangeproof string `json:"-"`
+}
+
+/**
+ * Decoded confidential transaction struct. (same as elements decoderawtransaction)
+ */
+type CfdDecodedConfidentialTx struct {
+	// txid
+	Txid string `json:"txid"`
+	// witness hash (elements sets the same value as wtxid)
+	Hash string `json:"hash"`
+	// witness txid (hash256 of the transaction with witness)
+	Wtxid string `json:"wtxid"`
+	// witness only hash (fast merkle root of the witness data)
+	Withash string `json:"withash"`
+	// transaction version
+	Version uint32 `json:"version"`
+	// transaction size
+	Size uint32 `json:"size"`
+	// virtual transaction size
+	Vsize uint32 `json:"vsize"`
+	// transaction weight
+	Weight uint32 `json:"weight"`
+	// locktime
+	Locktime uint32 `json:"locktime"`
+	// txin list
+	Vin []CfdDecodedConfidentialTxIn `json:"vin"`
+	// txout list
+	Vout []CfdDecodedConfidentialTxOut `json:"vout"`
+}
+
+/**
+ * Decode issuance of txin.
+ * param: handle        cfd handle
+ * param: txDataHandle  transaction data handle
+ * param: index         txin index
+ * param: txid          txin txid
+ * param: vout          txin vout
+ * return: issuance     decoded issuance (nil if not issuance)
+ * return: err          error
+ */
+func decodeIssuance(handle uintptr, txDataHandle uintptr, index uint32, txid string, vout uint32) (issuance *CfdDecodedIssuance, err error) {
+	entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, _, _, err := CfdGoGetTxInIssuanceInfoByHandle(handle, txDataHandle, index)
+	if err != nil || (assetValue == "" && tokenValue == "") {
+		return nil, err
+	}
+	issuance = &CfdDecodedIssuance{
+		AssetBlindingNonce: nonce,
+		IsReissuance:       nonce != emptyHash256,
+	}
+	isBlindIssuance := len(assetValue) == confidentialValueHexSize
+	issuance.AssetEntropy, issuance.Asset, issuance.Token, err = getIssuanceAsset(handle, txid, vout, nonce, entropy, isBlindIssuance)
+	if err != nil {
+		return nil, err
+	}
+	if isBlindIssuance {
+		issuance.AssetAmountCommitment = assetValue
+	} else if assetValue != "" {
+		amount := convertSatoshiToBitcoin(assetAmount)
+		issuance.AssetAmount = &amount
+	}
+	if len(tokenValue) == confidentialValueHexSize {
+		issuance.TokenAmountCommitment = tokenValue
+	} else if tokenValue != "" {
+		amount := convertSatoshiToBitcoin(tokenAmount)
+		issuance.TokenAmount = &amount
+	}
+	return issuance, nil
+}
+
+/**
+ * Decode txout.
+ * param: handle        cfd handle
+ * param: txDataHandle  transaction data handle
+ * param: index         txout index
+ * param: prefix        address prefix
+ * return: decoded      decoded txout
+ * return: err          error
+ */
+func decodeConfidentialTxOut(handle uintptr, txDataHandle uintptr, index uint32, prefix *addressPrefix) (decoded CfdDecodedConfidentialTxOut, err error) {
+	asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err := CfdGoGetConfidentialTxOutByHandle(handle, txDataHandle, index)
+	if err != nil {
+		return decoded, err
+	}
+	if len(valueCommitment) != confidentialValueHexSize {
+		value := convertSatoshiToBitcoin(satoshiAmount)
+		decoded.Value = &value
+	} else {
+		proof, _ := hex.DecodeString(rangeproof)
+		if exponent, mantissa, minValue, maxValue, ok := getRangeproofInfo(proof); ok {
+			minAmount := convertSatoshiToBitcoin(int64(minValue))
+			if exponent == -1 {
+				decoded.Value = &minAmount
+			} else {
+				maxAmount := convertSatoshiToBitcoin(int64(maxValue))
+				decoded.ValueMinimum = &minAmount
+				decoded.ValueMaximum = &maxAmount
+			}
+			decoded.CtExponent = &exponent
+			decoded.CtBits = &mantissa
+		}
+		decoded.SurjectionProof = surjectionProof
+		decoded.ValueCommitment = valueCommitment
+	}
+	if len(asset) == confidentialAssetHexSize {
+		decoded.AssetCommitment = asset
+	} else {
+		decoded.Asset = asset
+	}
+	nonceBytes, _ := hex.DecodeString(nonce)
+	decoded.CommitmentNonce = nonce
+	decoded.CommitmentNonceFullyValid = isValidPubkey(handle, nonceBytes)
+	decoded.N = index
+
+	script, _ := hex.DecodeString(lockingScript)
+	info := getLockingScriptInfo(handle, lockingScript, prefix.networkType)
+	decoded.ScriptPubKey = CfdDecodedScriptPubKey{
+		Asm:       scriptToAsm(script, false),
+		Hex:       lockingScript,
+		Type:      info.scriptType,
+		Addresses: info.addresses,
+	}
+	if len(decoded.ScriptPubKey.Addresses) > 0 {
+		decoded.ScriptPubKey.ReqSigs = info.reqSigs
+	}
+	if pegout, isPegout := parsePegoutLockingScript(handle, lockingScript); isPegout {
+		decodePegoutScriptPubKey(handle, pegout, prefix, &decoded.ScriptPubKey)
+	}
+	decoded.Rangeproof = rangeproof
+	return decoded, nil
+}
+
+/**
+ * Set pegout data to decoded scriptPubKey.
+ * param: handle        cfd handle
+ * param: pegout        pegout script data
+ * param: prefix        elements address prefix
+ * param: scriptPubKey  decoded scriptPubKey
+ */
+func decodePegoutScriptPubKey(handle uintptr, pegout *CfdPegoutData, prefix *addressPrefix, scriptPubKey *CfdDecodedScriptPubKey) {
+	mainchainLockingScript, _ := hex.DecodeString(pegout.MainchainLockingScript)
+	scriptPubKey.PegoutChain = pegout.MainchainGenesisBlockHash
+	scriptPubKey.PegoutAsm = scriptToAsm(mainchainLockingScript, false)
+	scriptPubKey.PegoutHex = pegout.MainchainLockingScript
+	info := getLockingScriptInfo(handle, scriptPubKey.PegoutHex, getPegoutMainchainNetworkType(prefix.networkType))
+	scriptPubKey.PegoutType = info.scriptType
+	scriptPubKey.PegoutAddresses = info.addresses
+	if len(scriptPubKey.PegoutAddresses) > 0 {
+		scriptPubKey.PegoutReqSigs = info.reqSigs
+	}
+}
+
+/**
+ * Get witness stack of txin.
+ * param: handle          cfd handle
+ * param: txDataHandle    transaction data handle
+ * param: isPeginWitness  target is pegin witness
+ * param: index           txin index
+ * return: stack          witness stack hex list (nil if empty)
+ * return: err            error
+ */
+func getTxInWitnessStack(handle uintptr, txDataHandle uintptr, isPeginWitness bool, index uint32) (stack []string, err error) {
+	count, err := CfdGoGetTxInWitnessCountByHandle(handle, txDataHandle, isPeginWitness, index)
+	for i := uint32(0); err == nil && i < count; i++ {
+		var data string
+		if data, err = CfdGoGetTxInWitnessByHandle(handle, txDataHandle, isPeginWitness, index, i); err == nil {
+			stack = append(stack, data)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
+
+/**
+ * Decode txin.
+ * param: handle        cfd handle
+ * param: txDataHandle  transaction data handle
+ * param: index         txin index
+ * param: isCoinbase    coinbase transaction flag
+ * return: decoded      decoded txin
+ * return: err          error
+ */
+func decodeConfidentialTxIn(handle uintptr, txDataHandle uintptr, index uint32, isCoinbase bool) (decoded CfdDecodedConfidentialTxIn, err error) {
+	txid, vout, sequence, scriptSig, err := CfdGoGetTxInByHandle(handle, txDataHandle, index)
+	if err != nil {
+		return decoded, err
+	}
+	decoded.Sequence = sequence
+	if decoded.TxinWitness, err = getTxInWitnessStack(handle, txDataHandle, false, index); err != nil {
+		return decoded, err
+	}
+	if decoded.PeginWitness, err = getTxInWitnessStack(handle, txDataHandle, true, index); err != nil {
+		return decoded, err
+	}
+	if isCoinbase {
+		decoded.Coinbase = scriptSig
+	} else {
+		script, _ := hex.DecodeString(scriptSig)
+		decoded.Txid = txid
+		decoded.Vout = vout
+		decoded.ScriptSig = CfdDecodedScriptSig{
+			Asm: scriptToAsm(script, true),
+			Hex: scriptSig,
+		}
+		decoded.IsPegin = len(decoded.PeginWitness) > 0
+	}
+	decoded.Issuance, err = decodeIssuance(handle, txDataHandle, index, txid, vout)
+	return decoded, err
+}
+
+/**
+ * Decode confidential transaction. (same as elements decoderawtransaction)
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: networkType   network type (elements only)
+ * return: data         decoded transaction
+ * return: err          error
+ */
+func CfdGoDecodeConfidentialTx(handle uintptr, txHex string, networkType NetworkType) (data CfdDecodedConfidentialTx, err error) {
+	prefix, err := getAddressPrefix(networkType)
+	if err == nil && !prefix.isElements {
+		err = errors.New("Illegal network type.")
+	}
+	if err != nil {
+		return data, convertGoError(err, "CfdGoDecodeConfidentialTx")
+	}
+
+	txDataHandle, err := CfdGoInitializeTxDataHandle(handle, networkType, txHex)
+	if err != nil {
+		return data, err
+	}
+	defer CfdGoFreeTxDataHandle(handle, txDataHandle)
+
+	txData, err := CfdGoGetConfidentialTxDataByHandle(handle, txDataHandle)
+	if err != nil {
+		return data, err
+	}
+	txinCount, err := CfdGoGetTxInCountByHandle(handle, txDataHandle)
+	if err != nil {
+		return data, err
+	}
+	txoutCount, err := CfdGoGetTxOutCountByHandle(handle, txDataHandle)
+	if err != nil {
+		return data, err
+	}
+	data = CfdDecodedConfidentialTx{
+		Txid:     txData.Txid,
+		Hash:     txData.Wtxid,
+		Wtxid:    txData.Wtxid,
+		Withash:  txData.WitHash,
+		Version:  txData.Version,
+		Size:     txData.Size,
+		Vsize:    txData.Vsize,
+		Weight:   txData.Weight,
+		Locktime: txData.LockTime,
+		Vin:      make([]CfdDecodedConfidentialTxIn, 0, txinCount),
+		Vout:     make([]CfdDecodedConfidentialTxOut, 0, txoutCount),
+	}
+
+	isCoinbase := false
+	if txinCount == 1 {
+		txid, vout, _, _, err := CfdGoGetTxInByHandle(handle, txDataHandle, uint32(0))
+		if err != nil {
+			return data, err
+		}
+		isCoinbase = txid == emptyHash256 && vout == 0xffffffff
+	}
+	for i := uint32(0); i < txinCount; i++ {
+		decoded, err := decodeConfidentialTxIn(handle, txDataHandle, i, isCoinbase)
+		if err != nil {
+			return data, err
+		}
+		data.Vin = append(data.Vin, decoded)
+	}
+	for i := uint32(0); i < txoutCount; i++ {
+		decoded, err := decodeConfidentialTxOut(handle, txDataHandle, i, prefix)
+		if err != nil {
+			return data, err
+		}
+		data.Vout = append(data.Vout, decoded)
+	}
+	return data, nil
+}
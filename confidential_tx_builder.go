@@ -0,0 +1,543 @@
+package cfdgo
+
+import (
+	"errors"
+)
+
+/**
+ * Blind input data struct.
+ */
+type CfdBlindInputData struct {
+	// utxo txid
+	Txid string
+	// utxo vout
+	Vout uint32
+	// utxo asset
+	Asset string
+	// utxo asset blind factor
+	AssetBlindFactor string
+	// utxo amount blind factor
+	ValueBlindFactor string
+	// utxo amount by satoshi
+	Amount int64
+	// issuance asset blinding key (issuance only)
+	AssetKey string
+	// issuance token blinding key (issuance only)
+	TokenKey string
+}
+
+/**
+ * Blind output data struct.
+ */
+type CfdBlindOutputData struct {
+	// txout index
+	Index uint32
+	// confidential key (blinding pubkey)
+	ConfidentialKey string
+}
+
+/**
+ * Confidential transaction builder.
+ * The transaction is kept as hex, and each method updates it through cfd.
+ * It is not safe for concurrent use.
+ */
+type ConfidentialTx struct {
+	handle *Handle
+	txHex  string
+}
+
+/**
+ * Create confidential transaction builder.
+ * param: version      transaction version
+ * param: locktime     locktime
+ * return: tx          confidential transaction builder. release: Close
+ * return: err         error
+ */
+func NewConfidentialTx(version uint32, locktime uint32) (tx *ConfidentialTx, err error) {
+	handle, err := NewHandle()
+	if err != nil {
+		return nil, err
+	}
+	tx = &ConfidentialTx{handle: handle}
+	err = handle.Do(func(rawHandle uintptr) (err error) {
+		tx.txHex, err = CfdGoInitializeConfidentialTx(rawHandle, version, locktime)
+		return err
+	})
+	if err != nil {
+		handle.Close()
+		return nil, err
+	}
+	return tx, nil
+}
+
+/**
+ * Create confidential transaction builder from transaction hex.
+ * param: txHex        transaction hex
+ * return: tx          confidential transaction builder. release: Close
+ * return: err         error
+ */
+func NewConfidentialTxFromHex(txHex string) (tx *ConfidentialTx, err error) {
+	handle, err := NewHandle()
+	if err != nil {
+		return nil, err
+	}
+	err = handle.Do(func(rawHandle uintptr) (err error) {
+		_, err = CfdGoGetConfidentialTxData(rawHandle, txHex)
+		return err
+	})
+	if err != nil {
+		handle.Close()
+		return nil, err
+	}
+	return &ConfidentialTx{handle: handle, txHex: txHex}, nil
+}
+
+/**
+ * Close the builder and release the cfd handle.
+ * return: err         error
+ */
+func (t *ConfidentialTx) Close() error {
+	return t.handle.Close()
+}
+
+/**
+ * Get transaction hex.
+ * return: txHex       transaction hex
+ */
+func (t *ConfidentialTx) ToHex() string {
+	return t.txHex
+}
+
+/**
+ * Get txid.
+ * return: txid        txid
+ * return: err         error
+ */
+func (t *ConfidentialTx) Txid() (txid string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		data, err := CfdGoGetConfidentialTxData(handle, t.txHex)
+		txid = data.Txid
+		return err
+	})
+	return txid, err
+}
+
+/**
+ * Get txin count.
+ * return: count       txin count
+ * return: err         error
+ */
+func (t *ConfidentialTx) GetTxInCount() (count uint32, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetConfidentialTxInCount(handle, t.txHex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get txout count.
+ * return: count       txout count
+ * return: err         error
+ */
+func (t *ConfidentialTx) GetTxOutCount() (count uint32, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetConfidentialTxOutCount(handle, t.txHex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Check txin exists.
+ * param: handle       cfd handle
+ * param: txid         txin txid
+ * param: vout         txin vout
+ * return: exist       txin exist flag
+ * return: err         error
+ */
+func (t *ConfidentialTx) hasTxIn(handle uintptr, txid string, vout uint32) (exist bool, err error) {
+	txDataHandle, err := CfdGoInitializeTxDataHandle(handle, KCfdNetworkLiquidv1, t.txHex)
+	if err != nil {
+		return false, err
+	}
+	defer CfdGoFreeTxDataHandle(handle, txDataHandle)
+
+	if _, err = CfdGoGetTxInIndexByHandle(handle, txDataHandle, txid, vout); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+/**
+ * Add txin.
+ * param: txid         txid
+ * param: vout         vout
+ * param: sequence     sequence
+ * return: index       txin index
+ * return: err         error
+ */
+func (t *ConfidentialTx) AddInput(txid string, vout uint32, sequence uint32) (index uint32, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		if exist, err := t.hasTxIn(handle, txid, vout); err != nil {
+			return err
+		} else if exist {
+			return convertGoError(errors.New("Txin is already exist."), "AddInput")
+		}
+		txHex, err := CfdGoAddConfidentialTxIn(handle, t.txHex, txid, vout, sequence)
+		if err != nil {
+			return err
+		}
+		if index, err = CfdGoGetConfidentialTxInCount(handle, txHex); err != nil {
+			return err
+		}
+		t.txHex = txHex
+		index--
+		return nil
+	})
+	return index, err
+}
+
+/**
+ * Add pegin txin.
+ * detail: the pegin witness is created by cfd.
+ * param: txid                       mainchain txid
+ * param: vout                       mainchain txout index
+ * param: sequence                   sequence
+ * param: amount                     pegin amount by satoshi (mainchain txout amount)
+ * param: asset                      pegin asset (L-BTC)
+ * param: mainchainGenesisBlockHash  mainchain genesis block hash
+ * param: claimScript                claim script hex (see: CfdGoGetPeginAddress)
+ * param: mainchainTxHex             mainchain transaction hex
+ * param: txoutProof                 mainchain txoutproof hex
+ * return: index                     txin index
+ * return: err                       error
+ */
+func (t *ConfidentialTx) AddPeginInput(txid string, vout uint32, sequence uint32, amount int64, asset string, mainchainGenesisBlockHash string, claimScript string, mainchainTxHex string, txoutProof string) (index uint32, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		if exist, err := t.hasTxIn(handle, txid, vout); err != nil {
+			return err
+		} else if exist {
+			return convertGoError(errors.New("Txin is already exist."), "AddPeginInput")
+		}
+		createHandle, err := CfdGoInitializeTransaction(handle, KCfdNetworkLiquidv1, uint32(0), uint32(0), t.txHex)
+		if err != nil {
+			return err
+		}
+		defer CfdGoFreeTransactionHandle(handle, createHandle)
+
+		if err = CfdGoAddTransactionInput(handle, createHandle, txid, vout, sequence); err != nil {
+			return err
+		}
+		err = CfdGoAddTxPeginInput(handle, createHandle, txid, vout, amount, asset, mainchainGenesisBlockHash, claimScript, mainchainTxHex, txoutProof)
+		if err != nil {
+			return err
+		}
+		txHex, err := CfdGoFinalizeTransaction(handle, createHandle)
+		if err != nil {
+			return err
+		}
+		if index, err = CfdGoGetConfidentialTxInCount(handle, txHex); err != nil {
+			return err
+		}
+		t.txHex = txHex
+		index--
+		return nil
+	})
+	return index, err
+}
+
+/**
+ * Add txout.
+ * param: asset                asset
+ * param: satoshiAmount        amount by satoshi
+ * param: valueCommitment      amount by commitment bytes.
+ * param: address              destination address
+ * param: directLockingScript  locking script for direct insert.
+ * param: nonce                confidential nonce
+ * return: index               txout index
+ * return: err                 error
+ */
+func (t *ConfidentialTx) AddOutput(asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (index uint32, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		txHex, err := CfdGoAddConfidentialTxOut(handle, t.txHex, asset, satoshiAmount, valueCommitment, address, directLockingScript, nonce)
+		if err != nil {
+			return err
+		}
+		if index, err = CfdGoGetConfidentialTxOutCount(handle, txHex); err != nil {
+			return err
+		}
+		t.txHex = txHex
+		index--
+		return nil
+	})
+	return index, err
+}
+
+/**
+ * Add pegout txout.
+ * param: asset                      pegout asset (L-BTC)
+ * param: satoshiAmount              amount by satoshi
+ * param: mainchainNetworkType       mainchain network type (mainnet, testnet, regtest)
+ * param: elementsNetworkType        elements network type (liquidv1, elements regtest)
+ * param: mainchainGenesisBlockHash  mainchain genesis block hash
+ * param: onlinePubkey               online pubkey hex (PAK only. empty if not using PAK)
+ * param: masterOnlineKey            master online privkey (PAK only. empty if not using PAK)
+ * param: mainchainOutputDescriptor  mainchain output descriptor or xpub
+ * param: bip32Counter               bip32 counter (derivation index of the descriptor)
+ * param: whitelist                  pak whitelist hex (PAK only. empty if not using PAK)
+ * return: index                     txout index
+ * return: mainchainAddress          mainchain pegout address
+ * return: err                       error
+ */
+func (t *ConfidentialTx) AddPegoutOutput(asset string, satoshiAmount int64, mainchainNetworkType NetworkType, elementsNetworkType NetworkType, mainchainGenesisBlockHash string, onlinePubkey string, masterOnlineKey string, mainchainOutputDescriptor string, bip32Counter uint32, whitelist string) (index uint32, mainchainAddress string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		createHandle, err := CfdGoInitializeTransaction(handle, elementsNetworkType, uint32(0), uint32(0), t.txHex)
+		if err != nil {
+			return err
+		}
+		defer CfdGoFreeTransactionHandle(handle, createHandle)
+
+		mainchainAddress, err = CfdGoAddTxPegoutOutput(handle, createHandle, asset, satoshiAmount, mainchainNetworkType, elementsNetworkType, mainchainGenesisBlockHash, onlinePubkey, masterOnlineKey, mainchainOutputDescriptor, bip32Counter, whitelist)
+		if err != nil {
+			return err
+		}
+		txHex, err := CfdGoFinalizeTransaction(handle, createHandle)
+		if err != nil {
+			return err
+		}
+		if index, err = CfdGoGetConfidentialTxOutCount(handle, txHex); err != nil {
+			return err
+		}
+		t.txHex = txHex
+		index--
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return index, mainchainAddress, nil
+}
+
+/**
+ * Set new asset issuance to txin. (see: CfdGoSetRawIssueAsset)
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: contractHash         contract hash (empty if not using contract)
+ * param: assetAmount          issue asset amount by satoshi
+ * param: assetAddress         asset destination address
+ * param: assetLockingScript   asset destination locking script (if address is empty)
+ * param: tokenAmount          issue token amount by satoshi (0 if not issue token)
+ * param: tokenAddress         token destination address
+ * param: tokenLockingScript   token destination locking script (if address is empty)
+ * param: isBlindAsset         blind issuance flag (for calculate token)
+ * return: entropy             asset entropy
+ * return: asset               issued asset
+ * return: token               reissuance token
+ * return: err                 error
+ */
+func (t *ConfidentialTx) SetIssuance(txid string, vout uint32, contractHash string, assetAmount int64, assetAddress string, assetLockingScript string, tokenAmount int64, tokenAddress string, tokenLockingScript string, isBlindAsset bool) (entropy string, asset string, token string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		var txHex string
+		entropy, asset, token, txHex, err = CfdGoSetRawIssueAsset(handle, t.txHex, txid, vout, contractHash, assetAmount, assetAddress, assetLockingScript, tokenAmount, tokenAddress, tokenLockingScript, isBlindAsset)
+		if err == nil {
+			t.txHex = txHex
+		}
+		return err
+	})
+	if err != nil {
+		return "", "", "", err
+	}
+	return entropy, asset, token, nil
+}
+
+/**
+ * Set reissuance to txin. (see: CfdGoSetRawReissueAsset)
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: assetAmount          reissue asset amount by satoshi
+ * param: blindingNonce        asset blinding nonce
+ * param: entropy              asset entropy
+ * param: address              destination address
+ * param: directLockingScript  destination locking script (if address is empty)
+ * return: asset               reissued asset
+ * return: err                 error
+ */
+func (t *ConfidentialTx) SetReissuance(txid string, vout uint32, assetAmount int64, blindingNonce string, entropy string, address string, directLockingScript string) (asset string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		var txHex string
+		asset, txHex, err = CfdGoSetRawReissueAsset(handle, t.txHex, txid, vout, assetAmount, blindingNonce, entropy, address, directLockingScript)
+		if err == nil {
+			t.txHex = txHex
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return asset, nil
+}
+
+/**
+ * Blind transaction.
+ * param: txinList     blind input data list
+ * param: txoutList    blind output data list
+ * return: err         error
+ */
+func (t *ConfidentialTx) Blind(txinList []CfdBlindInputData, txoutList []CfdBlindOutputData) (err error) {
+	return t.handle.Do(func(handle uintptr) (err error) {
+		blindHandle, err := CfdGoInitializeBlindTx(handle)
+		if err != nil {
+			return err
+		}
+		defer CfdGoFreeBlindHandle(handle, blindHandle)
+
+		for _, input := range txinList {
+			err = CfdGoAddBlindTxInData(handle, blindHandle, input.Txid, input.Vout,
+				input.Asset, input.AssetBlindFactor, input.ValueBlindFactor,
+				input.Amount, input.AssetKey, input.TokenKey)
+			if err != nil {
+				return err
+			}
+		}
+		for _, output := range txoutList {
+			err = CfdGoAddBlindTxOutData(handle, blindHandle, output.Index, output.ConfidentialKey)
+			if err != nil {
+				return err
+			}
+		}
+		txHex, err := CfdGoFinalizeBlindTx(handle, blindHandle, t.txHex)
+		if err == nil {
+			t.txHex = txHex
+		}
+		return err
+	})
+}
+
+/**
+ * Create sighash.
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: hashType             hash type
+ * param: pubkey               pubkey (p2pkh, p2wpkh, p2sh-p2wpkh)
+ * param: redeemScript         redeem script (p2sh, p2wsh, p2sh-p2wsh)
+ * param: satoshiAmount        txin amount by satoshi
+ * param: valueCommitment      txin amount commitment
+ * param: sighashType          sighash type
+ * param: sighashAnyoneCanPay  sighash anyone can pay flag
+ * return: sighash             signature hash
+ * return: err                 error
+ */
+func (t *ConfidentialTx) GetSighash(txid string, vout uint32, hashType HashType, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string, sighashType SigHashType, sighashAnyoneCanPay bool) (sighash string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		sighash, err = CfdGoCreateConfidentialSighash(handle, t.txHex, txid, vout, hashType, pubkey, redeemScript, satoshiAmount, valueCommitment, sighashType, sighashAnyoneCanPay)
+		return err
+	})
+	return sighash, err
+}
+
+/**
+ * Add sign data.
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: isWitness            insert sign data to witness stack
+ * param: signDataHex          sign data hex
+ * param: clearStack           cleanup stack
+ * return: err                 error
+ */
+func (t *ConfidentialTx) AddSign(txid string, vout uint32, isWitness bool, signDataHex string, clearStack bool) (err error) {
+	return t.handle.Do(func(handle uintptr) (err error) {
+		txHex, err := CfdGoAddConfidentialTxSign(handle, t.txHex, txid, vout, isWitness, signDataHex, clearStack)
+		if err == nil {
+			t.txHex = txHex
+		}
+		return err
+	})
+}
+
+/**
+ * Sign with privkey. (p2pkh, p2wpkh, p2sh-p2wpkh)
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: hashType             hash type
+ * param: privkeyHex           privkey hex (specify either privkeyHex or privkeyWif)
+ * param: privkeyWif           privkey WIF (the pubkey compression follows the WIF)
+ * param: wifNetworkType       network type of privkey WIF
+ * param: satoshiAmount        txin amount by satoshi
+ * param: valueCommitment      txin amount commitment
+ * param: sighashType          sighash type
+ * param: sighashAnyoneCanPay  sighash anyone can pay flag
+ * return: err                 error
+ */
+func (t *ConfidentialTx) Sign(txid string, vout uint32, hashType HashType, privkeyHex string, privkeyWif string, wifNetworkType NetworkType, satoshiAmount int64, valueCommitment string, sighashType SigHashType, sighashAnyoneCanPay bool) (err error) {
+	var isWitness bool
+	switch hashType {
+	case KCfdP2pkh:
+		isWitness = false
+	case KCfdP2wpkh, KCfdP2shP2wpkh:
+		isWitness = true
+	default:
+		return convertGoError(errors.New("Unsupported hash type."), "Sign")
+	}
+
+	return t.handle.Do(func(handle uintptr) (err error) {
+		isCompress := true
+		if privkeyHex == "" {
+			_, _, isCompress, err = CfdGoParsePrivkeyWif(handle, privkeyWif)
+			if err != nil {
+				return err
+			}
+		}
+		pubkey, err := CfdGoGetPubkeyFromPrivkey(handle, privkeyHex, privkeyWif, isCompress)
+		if err != nil {
+			return err
+		}
+		sighash, err := CfdGoCreateConfidentialSighash(handle, t.txHex, txid, vout, hashType, pubkey, "", satoshiAmount, valueCommitment, sighashType, sighashAnyoneCanPay)
+		if err != nil {
+			return err
+		}
+		signature, err := CfdGoCalculateEcSignature(handle, sighash, privkeyHex, privkeyWif, wifNetworkType, isCompress)
+		if err != nil {
+			return err
+		}
+		txHex, err := CfdGoAddConfidentialTxDerSign(handle, t.txHex, txid, vout, isWitness, signature, sighashType, sighashAnyoneCanPay, true)
+		if err != nil {
+			return err
+		}
+		txHex, err = CfdGoAddConfidentialTxSign(handle, txHex, txid, vout, isWitness, pubkey, false)
+		if err != nil {
+			return err
+		}
+		if hashType == KCfdP2shP2wpkh {
+			_, _, redeemScript, err := CfdGoCreateAddress(handle, KCfdP2shP2wpkh, pubkey, "", KCfdNetworkLiquidv1)
+			if err != nil {
+				return err
+			}
+			txHex, err = CfdGoAddConfidentialTxSign(handle, txHex, txid, vout, false, redeemScript, true)
+			if err != nil {
+				return err
+			}
+		}
+		t.txHex = txHex
+		return nil
+	})
+}
+
+/**
+ * Verify signed transaction input.
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: lockingScript        prevout locking script
+ * param: satoshiAmount        prevout amount by satoshi
+ * param: valueCommitment      prevout amount commitment
+ * return: isSuccess           verify result
+ * return: failReason          failure reason (empty if isSuccess is true)
+ * return: err                 error
+ */
+func (t *ConfidentialTx) VerifyTxIn(txid string, vout uint32, lockingScript string, satoshiAmount int64, valueCommitment string) (isSuccess bool, failReason string, err error) {
+	err = t.handle.Do(func(handle uintptr) (err error) {
+		isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, t.txHex, txid, vout, lockingScript, satoshiAmount, valueCommitment)
+		return err
+	})
+	return isSuccess, failReason, err
+}
@@ -0,0 +1,270 @@
+package cfdgo
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestConfidentialTxBuilder(t *testing.T) {
+	tx, err := NewConfidentialTx(uint32(2), uint32(0))
+	assert.NoError(t, err)
+	assert.Equal(t, "0200000000000000000000", tx.ToHex())
+
+	if err == nil {
+		index, err := tx.AddInput("7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd", uint32(0), uint32(4294967295))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), index)
+		index, err = tx.AddInput("1497e1f146bc5fe00b6268ea16a7069ecb90a2a41a183446d5df8965d2356dc1", uint32(1), uint32(4294967295))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), index)
+		assert.Equal(t, "020000000001bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0000000000", tx.ToHex())
+
+		// duplicate txin
+		_, err = tx.AddInput("7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd", uint32(0), uint32(4294967295))
+		assert.Error(t, err)
+	}
+
+	if err == nil {
+		_, err = tx.AddOutput(
+			"ef47c42d34de1b06a02212e8061323f50d5f02ceed202f1cb375932aa299f751",
+			int64(100000000), "",
+			"CTEw7oSCUWDfmfhCEdsB3gsG7D9b4xLCZEq71H8JxRFeBu7yQN3CbSF6qT6J4F7qji4bq1jVSdVcqvRJ",
+			"", "")
+		assert.NoError(t, err)
+	}
+	if err == nil {
+		_, err = tx.AddOutput(
+			"6f1a4b6bd5571b5f08ab79c314dc6483f9b952af2f5ef206cd6f8e68eb1186f3",
+			int64(1900500000), "",
+			"2dxZw5iVZ6Pmqoc5Vn8gkUWDGB5dXuMBCmM", "", "")
+		assert.NoError(t, err)
+	}
+	if err == nil {
+		index, err := tx.AddOutput(
+			"6f1a4b6bd5571b5f08ab79c314dc6483f9b952af2f5ef206cd6f8e68eb1186f3",
+			int64(500000), "", "", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), index)
+		txinCount, err := tx.GetTxInCount()
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), txinCount)
+		txoutCount, err := tx.GetTxOutCount()
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), txoutCount)
+		txid, err := tx.Txid()
+		assert.NoError(t, err)
+		assert.Equal(t, 64, len(txid))
+		assert.Equal(t, "020000000002bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffffc16d35d26589dfd54634181aa4a290cb9e06a716ea68620be05fbc46f1e197140100000000ffffffff020151f799a22a9375b31c2f20edce025f0df5231306e81222a0061bde342dc447ef010000000005f5e10003a630456ab6d50b57981e085abced70e2816289ae2b49a44c2f471b205134c12b1976a914d08f5ba8874d36cf97d19379b370f1f23ba36d5888ac01f38611eb688e6fcd06f25e2faf52b9f98364dc14c379ab085f1b57d56b4b1a6f010000000071475420001976a914fdd725970db682de970e7669646ed7afb8348ea188ac00000000", tx.ToHex())
+	}
+
+	err = tx.Close()
+	assert.NoError(t, err)
+	fmt.Print("TestConfidentialTxBuilder test done.\n")
+}
+
+func TestConfidentialTxBuilderIssuance(t *testing.T) {
+	tx, err := NewConfidentialTxFromHex("0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100000000ffffffff03017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000000000000")
+	assert.NoError(t, err)
+
+	if err == nil {
+		asset, err := tx.SetReissuance(
+			"57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(1),
+			int64(600000000),
+			"0b8954757234fd3ec9cf0dd6ef0a89d825ec56a9532e7da4b6cb90c51be3bbd8",
+			"6f9ccf5949eba5d6a08bff7a015e825c97824e82d57c8a0c77f9a41908fe8306",
+			"CTExCoUri8VzkxbbhqzgsruWJ5zYtmoFXxCWtjiSLAzcMbpEWhHmDrZ66bAb41VsmSKnvJWrq2cfjUw9", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "accb7354c07974e00b32e4e5eef55078490141675592ac3610e6101831edb0cd", asset)
+		assert.Equal(t, "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac00000000", tx.ToHex())
+	}
+
+	// txin not found
+	if err == nil {
+		_, err := tx.SetReissuance(
+			"57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(2),
+			int64(600000000),
+			"0b8954757234fd3ec9cf0dd6ef0a89d825ec56a9532e7da4b6cb90c51be3bbd8",
+			"6f9ccf5949eba5d6a08bff7a015e825c97824e82d57c8a0c77f9a41908fe8306",
+			"", "76a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac")
+		assert.Error(t, err)
+	}
+
+	if err == nil {
+		txinList := []CfdBlindInputData{
+			{
+				Txid:             "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f",
+				Vout:             uint32(0),
+				Asset:            "186c7f955149a5274b39e24b6a50d1d6479f552f6522d91f3a97d771f1c18179",
+				AssetBlindFactor: "a10ecbe1be7a5f883d5d45d966e30dbc1beff5f21c55cec76cc21a2229116a9f",
+				ValueBlindFactor: "ae0f46d1940f297c2dc3bbd82bf8ef6931a2431fbb05b3d3bc5df41af86ae808",
+				Amount:           int64(999637680),
+			},
+			{
+				Txid:             "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f",
+				Vout:             uint32(1),
+				Asset:            "ed6927df918c89b5e3d8b5062acab2c749a3291bb7451d4267c7daaf1b52ad0b",
+				AssetBlindFactor: "0b8954757234fd3ec9cf0dd6ef0a89d825ec56a9532e7da4b6cb90c51be3bbd8",
+				ValueBlindFactor: "62e36e1f0fa4916b031648a6b6903083069fa587572a88b729250cde528cfd3b",
+				Amount:           int64(700000000),
+				AssetKey:         "7d65c7970d836a878a1080399a3c11de39a8e82493e12b1ad154e383661fb77f",
+				TokenKey:         "7d65c7970d836a878a1080399a3c11de39a8e82493e12b1ad154e383661fb77f",
+			},
+		}
+		txoutList := []CfdBlindOutputData{
+			{Index: uint32(0), ConfidentialKey: "02200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d"},
+			{Index: uint32(1), ConfidentialKey: "02cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a"},
+			{Index: uint32(3), ConfidentialKey: "03ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed879"},
+		}
+		err = tx.Blind(txinList, txoutList)
+		assert.NoError(t, err)
+	}
+
+	if err == nil {
+		handle, err := CfdGoCreateHandle()
+		assert.NoError(t, err)
+		asset, value, _, _, err := CfdGoUnblindTxOut(
+			handle, tx.ToHex(), uint32(3),
+			"0473d39aa6542e0c1bb6a2343b2319c3e92063dd019af4d47dbf50c460204f32")
+		assert.NoError(t, err)
+		assert.Equal(t, "accb7354c07974e00b32e4e5eef55078490141675592ac3610e6101831edb0cd", asset)
+		assert.Equal(t, int64(600000000), value)
+		err = CfdGoFreeHandle(handle)
+		assert.NoError(t, err)
+	}
+
+	err = tx.Close()
+	assert.NoError(t, err)
+	fmt.Print("TestConfidentialTxBuilderIssuance test done.\n")
+}
+
+func TestConfidentialTxBuilderNewIssuance(t *testing.T) {
+	tx, err := NewConfidentialTxFromHex("0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100000000ffffffff03017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000000000000")
+	assert.NoError(t, err)
+
+	if err == nil {
+		entropy, asset, token, err := tx.SetIssuance(
+			"57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(0), "",
+			int64(100000000), "ert1qthklh702txwafc72d2qtxv7ywt7sk0mf52vwg7", "",
+			int64(1000000), "", "76a9145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f6988ac", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "0f508f27394825e6a1bdc43326710a2131ab288d2d0355af189a31c8d2fa8eb0", entropy)
+		assert.Equal(t, "bb55480d7c7267b5892e2f8ccf7cd68caf9d5289d1088d9ab2f577ac328153aa", asset)
+		assert.Equal(t, "604915fb50737a447ef02b5812a2d3a144311e9eb98901e63b67b49e3c058180", token)
+		txoutCount, err := tx.GetTxOutCount()
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(5), txoutCount)
+
+		// already has issuance
+		_, _, _, err = tx.SetIssuance(
+			"57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(0), "",
+			int64(100000000), "", "51", int64(0), "", "", false)
+		assert.Error(t, err)
+	}
+
+	err = tx.Close()
+	assert.NoError(t, err)
+	fmt.Print("TestConfidentialTxBuilderNewIssuance test done.\n")
+}
+
+func TestConfidentialTxBuilderPegin(t *testing.T) {
+	txid := "ba8c4c347817aa46be77df795d2cc737e88a46d311be2d0c47f9a0a89fb2bfa3"
+	asset := "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	genesisBlockHash := "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"
+	claimScript := "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69"
+	mainchainTxHex := "02000000000101000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f0100000000fdffffff0200e1f5050000000017a914036772f4b9575ab6f04d637800b72dd18600c55e8700093d000000000016001411111111111111111111111111111111111111110247304402202222222222222222222222222222222222222222222222222222222222222222022033333333333333333333333333333333333333333333333333333333333333330121022f8bde4d1a07209355b4a7250a5c5128e88b84bddc619ab7cba8d569b240efe400000000"
+	txoutProof := "0000002000000000000000000000000000000000000000000000000000000000000000004bfefe3edc2308d536827bfd3dadfce5f830bfeb8ea785bf07dd41ca68fc32ef00105e5fffff7f2000000000030000000364c9e17ec60cd8e074aa24fdb533b3147b651cc18d7c9bcd6b00ef483affc22fa3bfb29fa8a0f9470c2dbe11d3468ae837c72c5d79df77be46aa1778344c8cba09769d8c5ee2940e98b73ff40b20f0e4954b8cd78e8d2e5272c1843898cb5e91010b"
+
+	tx, err := NewConfidentialTx(uint32(2), uint32(0))
+	assert.NoError(t, err)
+
+	if err == nil {
+		index, err := tx.AddPeginInput(txid, uint32(0), uint32(4294967295), int64(100000000), asset, genesisBlockHash, claimScript, mainchainTxHex, txoutProof)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), index)
+		assert.Equal(t, "020000000101a3bfb29fa8a0f9470c2dbe11d3468ae837c72c5d79df77be46aa1778344c8cba0000004000ffffffff0000000000000000060800e1f505000000002025b251070e29ca19043cf33ccd7324e2ddab03ecc4ae0b5e77c4fc0e5cf6c95a2006226e46111a0b59caaf126043eb5bbf28c34f3a5e332a1fc7b2b73cf188910f1600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69720200000001000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f0100000000fdffffff0200e1f5050000000017a914036772f4b9575ab6f04d637800b72dd18600c55e8700093d0000000000160014111111111111111111111111111111111111111100000000b70000002000000000000000000000000000000000000000000000000000000000000000004bfefe3edc2308d536827bfd3dadfce5f830bfeb8ea785bf07dd41ca68fc32ef00105e5fffff7f2000000000030000000364c9e17ec60cd8e074aa24fdb533b3147b651cc18d7c9bcd6b00ef483affc22fa3bfb29fa8a0f9470c2dbe11d3468ae837c72c5d79df77be46aa1778344c8cba09769d8c5ee2940e98b73ff40b20f0e4954b8cd78e8d2e5272c1843898cb5e91010b", tx.ToHex())
+
+		// duplicate txin
+		_, err = tx.AddPeginInput(txid, uint32(0), uint32(4294967295), int64(100000000), asset, genesisBlockHash, claimScript, mainchainTxHex, txoutProof)
+		assert.Error(t, err)
+	}
+
+	if err == nil {
+		parsedTx, err := NewConfidentialTxFromHex(tx.ToHex())
+		assert.NoError(t, err)
+		if err == nil {
+			assert.Equal(t, tx.ToHex(), parsedTx.ToHex())
+			assert.NoError(t, parsedTx.Close())
+		}
+	}
+
+	err = tx.Close()
+	assert.NoError(t, err)
+	fmt.Print("TestConfidentialTxBuilderPegin test done.\n")
+}
+
+func TestConfidentialTxBuilderPegout(t *testing.T) {
+	asset := "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	genesisBlockHash := "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"
+	xpub := "tpubD6NzVbkrYhZ4XyJymmEgYC3uVhyj4YtPFX6yRTbW6RvfRC7Ag3sVhKSz7MNzFWW5MJ7aVBKXCAX7En296EYdpo43M4a4LaeaHuhhgHToSJF"
+	onlinePubkey := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	masterOnlineKey := "0000000000000000000000000000000000000000000000000000000000000001"
+	whitelist := "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5" + onlinePubkey
+
+	tx, err := NewConfidentialTx(uint32(2), uint32(0))
+	assert.NoError(t, err)
+
+	if err == nil {
+		// elements mainchain network
+		_, _, err := tx.AddPegoutOutput(asset, int64(100000000), KCfdNetworkElementsRegtest, KCfdNetworkElementsRegtest, genesisBlockHash, "", "", xpub, uint32(0), "")
+		assert.Error(t, err)
+		// invalid amount
+		_, _, err = tx.AddPegoutOutput(asset, int64(-1), KCfdNetworkRegtest, KCfdNetworkElementsRegtest, genesisBlockHash, "", "", xpub, uint32(0), "")
+		assert.Error(t, err)
+		txoutCount, err := tx.GetTxOutCount()
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), txoutCount)
+	}
+
+	var mainchainAddress, pakMainchainAddress string
+	if err == nil {
+		var index uint32
+		index, mainchainAddress, err = tx.AddPegoutOutput(asset, int64(100000000), KCfdNetworkRegtest, KCfdNetworkElementsRegtest, genesisBlockHash, "", "", xpub, uint32(0), "")
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), index)
+		assert.NotEqual(t, "", mainchainAddress)
+	}
+	if err == nil {
+		var index uint32
+		index, pakMainchainAddress, err = tx.AddPegoutOutput(asset, int64(100000000), KCfdNetworkRegtest, KCfdNetworkElementsRegtest, genesisBlockHash, onlinePubkey, masterOnlineKey, xpub, uint32(1), whitelist)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), index)
+		assert.NotEqual(t, mainchainAddress, pakMainchainAddress)
+	}
+
+	if err == nil {
+		handle, err := CfdGoCreateHandle()
+		assert.NoError(t, err)
+		decoded, err := CfdGoDecodeConfidentialTx(handle, tx.ToHex(), KCfdNetworkElementsRegtest)
+		assert.NoError(t, err)
+		if err == nil && len(decoded.Vout) == 2 {
+			scriptPubKey := decoded.Vout[0].ScriptPubKey
+			assert.Equal(t, "nulldata", scriptPubKey.Type)
+			assert.Equal(t, genesisBlockHash, scriptPubKey.PegoutChain)
+			assert.Equal(t, 1, scriptPubKey.PegoutReqSigs)
+			assert.Equal(t, []string{mainchainAddress}, scriptPubKey.PegoutAddresses)
+			assert.Equal(t, []string{pakMainchainAddress}, decoded.Vout[1].ScriptPubKey.PegoutAddresses)
+
+			pegout, err := CfdGoDecodePegoutLockingScript(handle, decoded.Vout[1].ScriptPubKey.Hex, KCfdNetworkRegtest)
+			assert.NoError(t, err)
+			assert.Equal(t, pakMainchainAddress, pegout.MainchainAddress)
+			assert.Equal(t, onlinePubkey, pegout.OnlinePubkey)
+			assert.NotEqual(t, "", pegout.WhitelistProof)
+		}
+		assert.NoError(t, CfdGoFreeHandle(handle))
+	}
+
+	err = tx.Close()
+	assert.NoError(t, err)
+	fmt.Print("TestConfidentialTxBuilderPegout test done.\n")
+}
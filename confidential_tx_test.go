@@ -0,0 +1,227 @@
+package cfdgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestCfdDecodeConfidentialTx(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	// signed p2wpkh
+	txHex := "0200000001020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac0000000000000247304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea012103f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d600000000000000000000000000"
+	data, err := CfdGoDecodeConfidentialTx(handle, txHex, KCfdNetworkElementsRegtest)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, "cf7783b2b1de646e35186df988a219a17f0317b5c3f3c47fa4ab2d7463ea3992", data.Txid)
+		assert.Equal(t, "2e3161237ab400a100d6b2a3f2e356f5cf3a6beeda0cffc230a899a68e401809", data.Hash)
+		assert.Equal(t, data.Hash, data.Wtxid)
+		assert.Equal(t, "3a035f58a8563c1bc44ea40ece8ffdad7f6caabd99800b991b0f7f93a01ee626", data.Withash)
+		assert.Equal(t, uint32(2), data.Version)
+		assert.Equal(t, uint32(634), data.Size)
+		assert.Equal(t, uint32(543), data.Vsize)
+		assert.Equal(t, uint32(2170), data.Weight)
+		assert.Equal(t, uint32(0), data.Locktime)
+		assert.Equal(t, 2, len(data.Vin))
+		assert.Equal(t, 4, len(data.Vout))
+
+		assert.Equal(t, "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", data.Vin[0].Txid)
+		assert.Equal(t, uint32(0), data.Vin[0].Vout)
+		assert.Equal(t, uint32(4294967295), data.Vin[0].Sequence)
+		assert.Equal(t, []string{
+			"304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea01",
+			"03f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6",
+		}, data.Vin[0].TxinWitness)
+		assert.Nil(t, data.Vin[0].Issuance)
+
+		issuance := data.Vin[1].Issuance
+		assert.NotNil(t, issuance)
+		if issuance != nil {
+			assert.Equal(t, "0b8954757234fd3ec9cf0dd6ef0a89d825ec56a9532e7da4b6cb90c51be3bbd8", issuance.AssetBlindingNonce)
+			assert.Equal(t, "6f9ccf5949eba5d6a08bff7a015e825c97824e82d57c8a0c77f9a41908fe8306", issuance.AssetEntropy)
+			assert.True(t, issuance.IsReissuance)
+			assert.Equal(t, "", issuance.Token)
+			assert.Equal(t, "accb7354c07974e00b32e4e5eef55078490141675592ac3610e6101831edb0cd", issuance.Asset)
+			assert.Equal(t, 6.0, *issuance.AssetAmount)
+			assert.Nil(t, issuance.TokenAmount)
+		}
+
+		txout := data.Vout[1]
+		assert.Equal(t, 7.0, *txout.Value)
+		assert.Equal(t, "ed6927df918c89b5e3d8b5062acab2c749a3291bb7451d4267c7daaf1b52ad0b", txout.Asset)
+		assert.Equal(t, "02cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a", txout.CommitmentNonce)
+		assert.True(t, txout.CommitmentNonceFullyValid)
+		assert.Equal(t, uint32(1), txout.N)
+		assert.Equal(t, "OP_DUP OP_HASH160 6c22e209d36612e0d9d2a20b814d7d8648cc7a77 OP_EQUALVERIFY OP_CHECKSIG", txout.ScriptPubKey.Asm)
+		assert.Equal(t, "76a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac", txout.ScriptPubKey.Hex)
+		assert.Equal(t, 1, txout.ScriptPubKey.ReqSigs)
+		assert.Equal(t, "pubkeyhash", txout.ScriptPubKey.Type)
+		assert.Equal(t, []string{"2djHX9wtrtdyGw9cer1u6zB6Yq4SRD8V5zw"}, txout.ScriptPubKey.Addresses)
+
+		feeOut := data.Vout[2]
+		assert.Equal(t, 0.0005, *feeOut.Value)
+		assert.Equal(t, "", feeOut.CommitmentNonce)
+		assert.False(t, feeOut.CommitmentNonceFullyValid)
+		assert.Equal(t, "fee", feeOut.ScriptPubKey.Type)
+		assert.Nil(t, feeOut.ScriptPubKey.Addresses)
+	}
+
+	// signed p2sh multisig
+	txHex = "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a15700000000d90047304402206fc4cc7e489208a2f4d24f5d35466debab2ce7aa34b5d00e0a9426c9d63529cf02202ec744939ef0b4b629c7d87bc2d017714b52bb86dccb0fd0f10148f62b7a09ba01473044022073ea24720b24c736bcb305a5de2fd8117ca2f0a85d7da378fae5b90dc361d227022004c0088bf1b73a56ae5ec407cf9c330d7206ffbcd0c9bb1c72661726fd4990390147522102bfd7daa5d113fcbd8c2f374ae58cbb89cbed9570e898f1af5ff989457e2d4d712102715ed9a5f16153c5216a6751b7d84eba32076f0b607550a58b209077ab7c30ad52aeffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac00000000"
+	data, err = CfdGoDecodeConfidentialTx(handle, txHex, KCfdNetworkElementsRegtest)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, data.Txid, data.Hash)
+		assert.Equal(t, data.Txid, data.Wtxid)
+		assert.Equal(t, "938e3a9b5bac410e812d08db74c4ef2bc58d1ed99d94b637cab0ac2e9eb59df8", data.Withash)
+		assert.Equal(t, uint32(729), data.Size)
+		assert.Equal(t, uint32(729), data.Vsize)
+		assert.Equal(t, uint32(2916), data.Weight)
+		assert.Equal(t, "0 304402206fc4cc7e489208a2f4d24f5d35466debab2ce7aa34b5d00e0a9426c9d63529cf02202ec744939ef0b4b629c7d87bc2d017714b52bb86dccb0fd0f10148f62b7a09ba[ALL] 3044022073ea24720b24c736bcb305a5de2fd8117ca2f0a85d7da378fae5b90dc361d227022004c0088bf1b73a56ae5ec407cf9c330d7206ffbcd0c9bb1c72661726fd499039[ALL] 522102bfd7daa5d113fcbd8c2f374ae58cbb89cbed9570e898f1af5ff989457e2d4d712102715ed9a5f16153c5216a6751b7d84eba32076f0b607550a58b209077ab7c30ad52ae", data.Vin[0].ScriptSig.Asm)
+		assert.Equal(t, "XZAEvLpasCN7rb5c6Cq8HfaQRSyJihQAcH", data.Vout[0].ScriptPubKey.Addresses[0])
+	}
+
+	// blinded txout and issuance
+	txHex = "0200000001010f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570200008000ffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000005f5e1000100000000000f4240020a11111111111111111111111111111111111111111111111111111111111111aa0822222222222222222222222222222222222222222222222222222222222222bb02200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d160014751e76e8199196d454941c45d1b3a323f1433bd601186c7f955149a5274b39e24b6a50d1d6479f552f6522d91f3a97d771f1c18179010000000000001388000000000000000001020203000301000146603300000000000000010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	data, err = CfdGoDecodeConfidentialTx(handle, txHex, KCfdNetworkLiquidv1)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, "be4abb3b4cb77004e72585dffe6831b0912f653cb12b1802ed426210b87b48ad", data.Txid)
+		assert.Equal(t, "9c4be3901c49e234632be6fc076ec1c51d1ae131918e51d775a496f9f92f55f8", data.Withash)
+		issuance := data.Vin[0].Issuance
+		assert.False(t, issuance.IsReissuance)
+		assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", issuance.AssetBlindingNonce)
+		assert.Equal(t, "1e012a2ff2034963b439dd7f8b041769e6955e69d351a277e0fcc76ead79aa43", issuance.AssetEntropy)
+		assert.Equal(t, "d3f138f2ca25d834f00790b41327b9c4a0ab3278bcf3e39597c541c50ec1be73", issuance.Asset)
+		assert.Equal(t, "255c5e0d6d51f932ec764ff29d940f2f222077a134963c3c41acf0a494185a1e", issuance.Token)
+		assert.Equal(t, 1.0, *issuance.AssetAmount)
+		assert.Equal(t, 0.01, *issuance.TokenAmount)
+
+		txout := data.Vout[0]
+		assert.Nil(t, txout.Value)
+		assert.Equal(t, 0.00000001, *txout.ValueMinimum)
+		assert.Equal(t, 45035996.27370496, *txout.ValueMaximum)
+		assert.Equal(t, 0, *txout.CtExponent)
+		assert.Equal(t, 52, *txout.CtBits)
+		assert.Equal(t, "010001", txout.SurjectionProof)
+		assert.Equal(t, "0822222222222222222222222222222222222222222222222222222222222222bb", txout.ValueCommitment)
+		assert.Equal(t, "", txout.Asset)
+		assert.Equal(t, "0a11111111111111111111111111111111111111111111111111111111111111aa", txout.AssetCommitment)
+		assert.Equal(t, "witness_v0_keyhash", txout.ScriptPubKey.Type)
+		assert.Equal(t, []string{"ex1qw508d6qejxtdg4y5r3zarvary0c5xw7kxw5fx4"}, txout.ScriptPubKey.Addresses)
+
+		jsonData, err := json.Marshal(data.Vout[0])
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(jsonData), "{\"value-minimum\":1e-8,\"value-maximum\":45035996.27370496,\"ct-exponent\":0,\"ct-bits\":52,"))
+		assert.False(t, strings.Contains(string(jsonData), "\"value\":"))
+	}
+
+	_, err = CfdGoDecodeConfidentialTx(handle, "0200000000", KCfdNetworkLiquidv1)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+	_, err = CfdGoDecodeConfidentialTx(handle, txHex, KCfdNetworkMainnet)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdDecodeConfidentialTx test done.\n")
+}
+
+func TestCfdTxDataHandle(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	txHex := "0200000001020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac0000000000000247304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea012103f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d600000000000000000000000000"
+	txDataHandle, err := CfdGoInitializeTxDataHandle(handle, KCfdNetworkElementsRegtest, txHex)
+	assert.NoError(t, err)
+	if err == nil {
+		data, err := CfdGoGetConfidentialTxDataByHandle(handle, txDataHandle)
+		assert.NoError(t, err)
+		assert.Equal(t, "cf7783b2b1de646e35186df988a219a17f0317b5c3f3c47fa4ab2d7463ea3992", data.Txid)
+
+		index, err := CfdGoGetTxInIndexByHandle(handle, txDataHandle, "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(1))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), index)
+		_, err = CfdGoGetTxInIndexByHandle(handle, txDataHandle, "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f", uint32(2))
+		assert.Error(t, err)
+
+		count, err := CfdGoGetTxInWitnessCountByHandle(handle, txDataHandle, false, uint32(0))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), count)
+		stackData, err := CfdGoGetTxInWitnessByHandle(handle, txDataHandle, false, uint32(0), uint32(1))
+		assert.NoError(t, err)
+		assert.Equal(t, "03f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6", stackData)
+		count, err = CfdGoGetTxInWitnessCountByHandle(handle, txDataHandle, true, uint32(0))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(0), count)
+
+		_, nonce, assetAmount, _, _, tokenValue, _, _, err := CfdGoGetTxInIssuanceInfoByHandle(handle, txDataHandle, uint32(1))
+		assert.NoError(t, err)
+		assert.Equal(t, "0b8954757234fd3ec9cf0dd6ef0a89d825ec56a9532e7da4b6cb90c51be3bbd8", nonce)
+		assert.Equal(t, int64(600000000), assetAmount)
+		assert.Equal(t, "", tokenValue)
+
+		err = CfdGoFreeTxDataHandle(handle, txDataHandle)
+		assert.NoError(t, err)
+	}
+
+	_, err = CfdGoInitializeTxDataHandle(handle, KCfdNetworkLiquidv1, "0200000000")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdTxDataHandle test done.\n")
+}
+
+func TestCfdSetRawIssueAsset(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	txHex := "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100000000ffffffff03017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000000000000"
+	txid := "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f"
+
+	entropy, asset, token, outTxHex, err := CfdGoSetRawIssueAsset(
+		handle, txHex, txid, uint32(0), "",
+		int64(100000000), "ert1qthklh702txwafc72d2qtxv7ywt7sk0mf52vwg7", "",
+		int64(1000000), "", "76a9145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f6988ac", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "0f508f27394825e6a1bdc43326710a2131ab288d2d0355af189a31c8d2fa8eb0", entropy)
+	assert.Equal(t, "bb55480d7c7267b5892e2f8ccf7cd68caf9d5289d1088d9ab2f577ac328153aa", asset)
+	assert.Equal(t, "604915fb50737a447ef02b5812a2d3a144311e9eb98901e63b67b49e3c058180", token)
+	assert.Equal(t, "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000008000ffffffff00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010000000005f5e1000100000000000f42400f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100000000ffffffff05017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001aa538132ac77f5b29a8d08d189529daf8cd67ccf8c2f2e89b567727c0d4855bb010000000005f5e100001600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69018081053c9eb4673be60189b99e1e3144a1d3a212582bf07e447a7350fb1549600100000000000f4240001976a9145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f6988ac00000000", outTxHex)
+
+	// contract hash, blind issuance
+	entropy, asset, token, outTxHex, err = CfdGoSetRawIssueAsset(
+		handle, txHex, txid, uint32(0), "ccfb7a7e0f4d9a3a7c4c6d2b8e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f",
+		int64(100000000), "", "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69",
+		int64(1000000), "2dhzQKoB2bQH7nJ8z3jP9LXCb7H16eWrir7", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "fff78b891adeb215a776a5fd7ca9d434885707208c5017db258b9c99ac436f89", entropy)
+	assert.Equal(t, "f49b74a1e39d4e4272478d224410474c28c319058ba9b5a4d5c2b608354d6dfc", asset)
+	assert.Equal(t, "c4dd41e9b127c7c412f4df1200f4a72367e92ebd5b1bea69ba9bbd1a1ce6550d", token)
+	assert.Equal(t, "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000008000ffffffff00000000000000000000000000000000000000000000000000000000000000003f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0a1f8e2b6d4c7c3a9a4d0f7e7afbcc010000000005f5e1000100000000000f42400f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100000000ffffffff05017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001fc6d4d3508b6c2d5a4b5a98b0519c3284c471044228d4772424e9de3a1749bf4010000000005f5e100001600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69010d55e61c1abd9bba69ea1b5bbd2ee96723a7f40012dff412c4c727b1e941ddc40100000000000f4240001976a9145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f6988ac00000000", outTxHex)
+
+	// already has issuance
+	_, _, _, _, err = CfdGoSetRawIssueAsset(handle, outTxHex, txid, uint32(0), "", int64(100000000), "", "51", int64(0), "", "", false)
+	assert.Error(t, err)
+	// txin not found
+	_, _, _, _, err = CfdGoSetRawIssueAsset(handle, txHex, txid, uint32(2), "", int64(100000000), "", "51", int64(0), "", "", false)
+	assert.Error(t, err)
+	// empty amount
+	_, _, _, _, err = CfdGoSetRawIssueAsset(handle, txHex, txid, uint32(0), "", int64(0), "", "51", int64(0), "", "51", false)
+	assert.Error(t, err)
+	// empty token destination
+	_, _, _, _, err = CfdGoSetRawIssueAsset(handle, txHex, txid, uint32(0), "", int64(100000000), "", "51", int64(1000000), "", "", false)
+	assert.Error(t, err)
+	// bitcoin address
+	_, _, _, _, err = CfdGoSetRawIssueAsset(handle, txHex, txid, uint32(0), "", int64(100000000), "bcrt1qthklh702txwafc72d2qtxv7ywt7sk0mfv7esk7", "", int64(0), "", "", false)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdSetRawIssueAsset test done.\n")
+}
@@ -0,0 +1,86 @@
+package cfdgo
+
+import (
+	"errors"
+)
+
+// witness version of signature verification (cfd_common.h: CfdWitnessVersion)
+const (
+	witnessVersionNone = -1
+	witnessVersion0    = 0
+)
+
+// txin verification failure reason (unsupported locking script)
+const verifyErrUnsupportedScript = "Locking script is not supported."
+
+/**
+ * Get scriptSig of txin.
+ * param: handle           cfd handle
+ * param: txHex            transaction hex
+ * param: txid             txin txid
+ * param: vout             txin vout
+ * return: scriptSig       scriptSig hex
+ * return: err             error
+ */
+func getTxInScriptSig(handle uintptr, txHex string, txid string, vout uint32) (scriptSig string, err error) {
+	txDataHandle, err := CfdGoInitializeTxDataHandle(handle, KCfdNetworkLiquidv1, txHex)
+	if err != nil {
+		return "", err
+	}
+	defer CfdGoFreeTxDataHandle(handle, txDataHandle)
+
+	index, err := CfdGoGetTxInIndexByHandle(handle, txDataHandle, txid, vout)
+	if err != nil {
+		return "", err
+	}
+	_, _, _, scriptSig, err = CfdGoGetTxInByHandle(handle, txDataHandle, index)
+	return scriptSig, err
+}
+
+/**
+ * Get address type of signed txin for verification.
+ * detail: p2sh is resolved to p2sh-p2wpkh or p2sh-p2wsh by the last
+ *         scriptSig push (redeem script).
+ * param: handle           cfd handle
+ * param: txHex            transaction hex
+ * param: txid             txin txid
+ * param: vout             txin vout
+ * param: lockingScript    prevout locking script hex
+ * return: addressType     address type
+ * return: isSupported     supported locking script flag
+ * return: err             error
+ */
+func getVerifyAddressType(handle uintptr, txHex string, txid string, vout uint32, lockingScript string) (addressType AddressType, isSupported bool, err error) {
+	scriptSig, err := getTxInScriptSig(handle, txHex, txid, vout)
+	if err != nil {
+		return addressType, false, err
+	}
+	if lockingScript == "" {
+		return addressType, false, errors.New("Invalid locking script.")
+	}
+
+	// the script type does not depend on the network.
+	switch getLockingScriptInfo(handle, lockingScript, KCfdNetworkLiquidv1).scriptType {
+	case scriptTypePubkeyHash:
+		return KCfdP2pkhAddress, true, nil
+	case scriptTypeWitnessV0KeyHash:
+		return KCfdP2wpkhAddress, true, nil
+	case scriptTypeWitnessV0ScriptHash:
+		return KCfdP2wshAddress, true, nil
+	case scriptTypeScriptHash:
+		items, err := CfdGoParseScript(handle, scriptSig)
+		if err != nil || len(items) == 0 {
+			return KCfdP2shAddress, true, nil
+		}
+		switch getLockingScriptInfo(handle, items[len(items)-1], KCfdNetworkLiquidv1).scriptType {
+		case scriptTypeWitnessV0KeyHash:
+			return KCfdP2shP2wpkhAddress, true, nil
+		case scriptTypeWitnessV0ScriptHash:
+			return KCfdP2shP2wshAddress, true, nil
+		default:
+			return KCfdP2shAddress, true, nil
+		}
+	default:
+		return addressType, false, nil
+	}
+}
@@ -0,0 +1,118 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyConfidentialTxSign(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	txid := "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f"
+	vout := uint32(0)
+	amount := int64(13000000000000)
+
+	// p2wpkh
+	p2wpkhTx := "0200000001020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac0000000000000247304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea012103f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d600000000000000000000000000"
+	isSuccess, failReason, err := CfdGoVerifyConfidentialTxSign(handle, p2wpkhTx, txid, vout, "0014eb3c0d55b7098a4aef4a18ee1eebcb1ed924a82b", amount, "")
+	assert.NoError(t, err)
+	assert.True(t, isSuccess)
+	assert.Equal(t, "", failReason)
+
+	// p2sh-p2wpkh (scriptSig is empty)
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2wpkhTx, txid, vout, "a914"+"ef3e40882e17d6e477082fcafeb0f09dc32d377b"+"87", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.NotEqual(t, "", failReason)
+
+	// p2wpkh with different amount
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2wpkhTx, txid, vout, "0014eb3c0d55b7098a4aef4a18ee1eebcb1ed924a82b", amount+1, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.NotEqual(t, "", failReason)
+
+	// p2wpkh with different pubkey hash
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2wpkhTx, txid, vout, "00146c22e209d36612e0d9d2a20b814d7d8648cc7a77", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.NotEqual(t, "", failReason)
+
+	// p2pkh
+	p2pkhTx := "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a157000000006a47304402204c5f91208f79fe7c74a2b5d88573b6150ac1d4f18cef8051dff1260a37c272d802201b97ecd5f83d16cfc3cb39d9bdd21d1f77665135c4230a3157d2045450528ff5012103f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac00000000"
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2pkhTx, txid, vout, "76a914eb3c0d55b7098a4aef4a18ee1eebcb1ed924a82b88ac", amount, "")
+	assert.NoError(t, err)
+	assert.True(t, isSuccess)
+	assert.Equal(t, "", failReason)
+
+	// p2pkh (unsigned input)
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2pkhTx, txid, uint32(1), "76a914eb3c0d55b7098a4aef4a18ee1eebcb1ed924a82b88ac", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.NotEqual(t, "", failReason)
+
+	// p2sh multisig
+	multisigTx := "0200000000020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a15700000000d90047304402206fc4cc7e489208a2f4d24f5d35466debab2ce7aa34b5d00e0a9426c9d63529cf02202ec744939ef0b4b629c7d87bc2d017714b52bb86dccb0fd0f10148f62b7a09ba01473044022073ea24720b24c736bcb305a5de2fd8117ca2f0a85d7da378fae5b90dc361d227022004c0088bf1b73a56ae5ec407cf9c330d7206ffbcd0c9bb1c72661726fd4990390147522102bfd7daa5d113fcbd8c2f374ae58cbb89cbed9570e898f1af5ff989457e2d4d712102715ed9a5f16153c5216a6751b7d84eba32076f0b607550a58b209077ab7c30ad52aeffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac00000000"
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, multisigTx, txid, vout, "a9140b20963675d6c6e335d96fda2b6e68b437e54b4787", amount, "")
+	assert.NoError(t, err)
+	assert.True(t, isSuccess)
+	assert.Equal(t, "", failReason)
+
+	// p2sh multisig with different script hash
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, multisigTx, txid, vout, "a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.NotEqual(t, "", failReason)
+
+	// unsupported locking script
+	isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, p2pkhTx, txid, vout, "6a0100", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isSuccess)
+	assert.Equal(t, "Locking script is not supported.", failReason)
+
+	// invalid argument
+	_, _, err = CfdGoVerifyConfidentialTxSign(handle, p2pkhTx, txid, uint32(2), "76a914eb3c0d55b7098a4aef4a18ee1eebcb1ed924a82b88ac", amount, "")
+	assert.Error(t, err)
+	_, _, err = CfdGoVerifyConfidentialTxSign(handle, p2pkhTx, txid, vout, "", amount, "")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestVerifyConfidentialTxSign test done.\n")
+}
+
+func TestVerifyConfidentialTxSignature(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	txHex := "0200000001020f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570000000000ffffffff0f231181a6d8fa2c5f7020948464110fbcc925f94d673d5752ce66d00250a1570100008000ffffffffd8bbe31bc590cbb6a47d2e53a956ec25d8890aefd60dcfc93efd34727554890b0683fe0819a4f9770c8a7cd5824e82975c825e017aff8ba0d6a5eb4959cf9c6f010000000023c346000004017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000003b947f6002200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d17a914ef3e40882e17d6e477082fcafeb0f09dc32d377b87010bad521bafdac767421d45b71b29a349c7b2ca2a06b5d8e3b5898c91df2769ed010000000029b9270002cc645552109331726c0ffadccab21620dd7a5a33260c6ac7bd1c78b98cb1e35a1976a9146c22e209d36612e0d9d2a20b814d7d8648cc7a7788ac017981c1f171d7973a1fd922652f559f47d6d1506a4be2394b27a54951957f6c1801000000000000c350000001cdb0ed311810e61036ac9255674101497850f5eee5e4320be07479c05473cbac010000000023c3460003ce4c4eac09fe317f365e45c00ffcf2e9639bc0fd792c10f72cdc173c4e5ed8791976a9149bdcb18911fa9faad6632ca43b81739082b0a19588ac0000000000000247304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea012103f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d600000000000000000000000000"
+	txid := "57a15002d066ce52573d674df925c9bc0f1164849420705f2cfad8a68111230f"
+	vout := uint32(0)
+	pubkey := "03f942716865bb9b62678d99aa34de4632249d066d99de2b5a2e542e54908450d6"
+	signature := "304402200268633a57723c6612ef217c49bdf804c632a14be2967c76afec4fd5781ad4c20220131f358b2381a039c8c502959c64fbfeccf287be7dae710b4446968553aefbea01"
+	amount := int64(13000000000000)
+
+	isVerify, err := CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, KCfdP2wpkh, signature, pubkey, "", amount, "")
+	assert.NoError(t, err)
+	assert.True(t, isVerify)
+
+	isVerify, err = CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, KCfdP2pkh, signature, pubkey, "", amount, "")
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+
+	// value commitment (explicit value is not a commitment)
+	isVerify, err = CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, KCfdP2wpkh, signature, pubkey, "", amount, "0994cf3c48ee3e1a97a20f0bb6fd6c78af9e0a0a9e38fd35dfba2fd1d4bdd38545")
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+
+	_, err = CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, KCfdP2wsh, signature, pubkey, "", amount, "")
+	assert.Error(t, err)
+	_, err = CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, HashType(100), signature, pubkey, "", amount, "")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestVerifyConfidentialTxSignature test done.\n")
+}
@@ -0,0 +1,681 @@
+package cfdgo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/**
+ * Descriptor checksum mismatch error. (compare by errors.Is)
+ * detail: the api error is CfdError, and it wraps this error.
+ */
+var ErrInvalidDescriptorChecksum = errors.New("Invalid descriptor checksum.")
+
+/**
+ * Split descriptor and checksum.
+ * detail: if checksum exists, it is validated.
+ * param: handle       cfd handle
+ * param: descriptor   descriptor (with or without checksum)
+ * param: networkType  network type
+ * return: body        descriptor without checksum
+ * return: checksum    checksum (empty if not exists)
+ * return: err         error
+ */
+func splitDescriptorChecksum(handle uintptr, descriptor string, networkType NetworkType) (body string, checksum string, err error) {
+	position := strings.LastIndex(descriptor, "#")
+	if position < 0 {
+		return descriptor, "", nil
+	}
+	body = descriptor[:position]
+	checksum = descriptor[position+1:]
+	expected, err := CfdGoGetDescriptorChecksum(handle, body, networkType)
+	if err != nil {
+		return "", "", err
+	}
+	if checksum != expected {
+		return "", "", ErrInvalidDescriptorChecksum
+	}
+	return body, checksum, nil
+}
+
+/**
+ * Append checksum to descriptor.
+ * detail: if checksum exists, it is validated and the descriptor is returned as is.
+ * param: handle                 cfd handle
+ * param: descriptor             output descriptor
+ * param: networkType            network type
+ * return: descriptorWithChecksum  output descriptor with checksum
+ * return: err                   error (ErrInvalidDescriptorChecksum on checksum mismatch)
+ */
+func CfdGoAppendDescriptorChecksum(handle uintptr, descriptor string, networkType NetworkType) (descriptorWithChecksum string, err error) {
+	body, checksum, err := splitDescriptorChecksum(handle, descriptor, networkType)
+	if err == nil && checksum == "" {
+		checksum, err = CfdGoGetDescriptorChecksum(handle, body, networkType)
+	}
+	if err != nil {
+		return "", convertGoError(err, "CfdGoAppendDescriptorChecksum")
+	}
+	return body + "#" + checksum, nil
+}
+
+/**
+ * Verify descriptor checksum.
+ * param: handle       cfd handle
+ * param: descriptor   output descriptor with checksum
+ * param: networkType  network type
+ * return: err         error (ErrInvalidDescriptorChecksum on checksum mismatch)
+ */
+func CfdGoVerifyDescriptorChecksum(handle uintptr, descriptor string, networkType NetworkType) (err error) {
+	_, checksum, err := splitDescriptorChecksum(handle, descriptor, networkType)
+	if err == nil && checksum == "" {
+		err = errors.New("Descriptor checksum is not found.")
+	}
+	return convertGoError(err, "CfdGoVerifyDescriptorChecksum")
+}
+
+// descriptor serializer constants
+const (
+	descriptorMaxMultisigKeyNum  = 20
+	descriptorMaxScriptSize      = 520
+	descriptorMaxDerivationIndex = 0x7fffffff
+)
+
+/**
+ * Descriptor key origin struct.
+ */
+type CfdDescriptorKeyOrigin struct {
+	// master key fingerprint hex (4 bytes)
+	Fingerprint string
+	// derivation path from the master key (ex: 84'/0'/0')
+	DerivationPath string
+}
+
+/**
+ * Normalize bip32 derivation path of descriptor.
+ * detail: hardened marker ('h', 'H') is converted to "'".
+ * param: path             derivation path (without 'm/')
+ * param: allowWildcard    allow wildcard ('*') at the last element
+ * return: normalizedPath  normalized derivation path
+ * return: err             error
+ */
+func normalizeDescriptorPath(path string, allowWildcard bool) (normalizedPath string, err error) {
+	if path == "" {
+		return "", nil
+	}
+	elements := strings.Split(path, "/")
+	for i, element := range elements {
+		isHardened := false
+		if last := len(element) - 1; last > 0 && strings.IndexByte("'hH", element[last]) >= 0 {
+			element = element[:last]
+			isHardened = true
+		}
+		if element == "*" {
+			if !allowWildcard || i != len(elements)-1 {
+				return "", errors.New("Invalid derivation path wildcard.")
+			}
+		} else {
+			index, err := strconv.ParseUint(element, 10, 32)
+			if err != nil || index > descriptorMaxDerivationIndex {
+				return "", errors.New("Invalid derivation path.")
+			}
+		}
+		if isHardened {
+			element += "'"
+		}
+		elements[i] = element
+	}
+	return strings.Join(elements, "/"), nil
+}
+
+/**
+ * Create descriptor key origin string.
+ * param: keyOrigin    key origin
+ * return: origin      key origin string (ex: [d34db33f/84'/0'/0'])
+ * return: err         error
+ */
+func createDescriptorKeyOrigin(keyOrigin CfdDescriptorKeyOrigin) (origin string, err error) {
+	path := strings.TrimPrefix(strings.TrimPrefix(keyOrigin.DerivationPath, "m"), "/")
+	if keyOrigin.Fingerprint == "" {
+		if path != "" {
+			return "", errors.New("Key origin fingerprint is required.")
+		}
+		return "", nil
+	}
+	fingerprint, err := hex.DecodeString(keyOrigin.Fingerprint)
+	if err != nil || len(fingerprint) != extkeyFingerprintSize {
+		return "", errors.New("Invalid key origin fingerprint.")
+	}
+	if path, err = normalizeDescriptorPath(path, false); err != nil {
+		return "", err
+	}
+	origin = "[" + hex.EncodeToString(fingerprint)
+	if path != "" {
+		origin += "/" + path
+	}
+	return origin + "]", nil
+}
+
+/**
+ * Parse descriptor key origin.
+ * param: key          descriptor key string (ex: [d34db33f/84'/0'/0']xpub...)
+ * return: keyOrigin   key origin (empty if the key has no origin)
+ * return: err         error
+ */
+func parseDescriptorKeyOrigin(key string) (keyOrigin CfdDescriptorKeyOrigin, err error) {
+	if !strings.HasPrefix(key, "[") {
+		return keyOrigin, nil
+	}
+	end := strings.IndexByte(key, ']')
+	if end < 0 {
+		return keyOrigin, errors.New("Invalid key origin.")
+	}
+	origin := key[1:end]
+	path := ""
+	if position := strings.IndexByte(origin, '/'); position >= 0 {
+		origin, path = origin[:position], origin[position+1:]
+	}
+	fingerprint, err := hex.DecodeString(origin)
+	if err != nil || len(fingerprint) != extkeyFingerprintSize {
+		return keyOrigin, errors.New("Invalid key origin fingerprint.")
+	}
+	if path, err = normalizeDescriptorPath(path, false); err != nil {
+		return keyOrigin, err
+	}
+	keyOrigin.Fingerprint = hex.EncodeToString(fingerprint)
+	keyOrigin.DerivationPath = path
+	return keyOrigin, nil
+}
+
+/**
+ * Split descriptor script.
+ * param: descriptor   descriptor (without checksum)
+ * return: wrappers    wrapper script names (outermost first. ex: sh, wsh)
+ * return: name        inner script name (ex: wpkh, multi, addr)
+ * return: args        inner script arguments
+ * return: err         error
+ */
+func splitDescriptorScript(descriptor string) (wrappers []string, name string, args []string, err error) {
+	script := descriptor
+	for {
+		start := strings.IndexByte(script, '(')
+		if start < 0 || !strings.HasSuffix(script, ")") {
+			return nil, "", nil, errors.New("Invalid descriptor format.")
+		}
+		name = script[:start]
+		script = script[start+1 : len(script)-1]
+		if name != "sh" && name != "wsh" {
+			return wrappers, name, strings.Split(script, ","), nil
+		}
+		wrappers = append(wrappers, name)
+	}
+}
+
+/**
+ * Get descriptor key list.
+ * detail: keys are listed in the order of the descriptor.
+ * param: descriptor   descriptor (without checksum)
+ * return: keys        descriptor key string list (with key origin)
+ * return: err         error
+ */
+func getDescriptorKeys(descriptor string) (keys []string, err error) {
+	_, name, args, err := splitDescriptorScript(descriptor)
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "addr", "raw":
+		return []string{}, nil
+	case "multi", "sortedmulti":
+		return args[1:], nil
+	default:
+		return args, nil
+	}
+}
+
+/**
+ * Set key origin to the parsed descriptor data.
+ * detail: cfd does not output the key origin, so the origin of the key at the
+ *         same position in the descriptor is set. the multisig key list of cfd
+ *         keeps the descriptor order. (sortedmulti too)
+ * param: descriptor           descriptor (without checksum)
+ * param: descriptorDataList   descriptor data list
+ * param: multisigList         multisig key list
+ * return: err                 error
+ */
+func setDescriptorKeyOrigins(descriptor string, descriptorDataList []CfdDescriptorData, multisigList []CfdDescriptorKeyData) error {
+	keys, err := getDescriptorKeys(descriptor)
+	if err != nil {
+		return err
+	}
+	keyOriginList := make([]CfdDescriptorKeyOrigin, len(keys))
+	for i, key := range keys {
+		if keyOriginList[i], err = parseDescriptorKeyOrigin(key); err != nil {
+			return err
+		}
+	}
+	if len(multisigList) > 0 {
+		if len(multisigList) != len(keys) {
+			return errors.New("Descriptor key count mismatch.")
+		}
+		for i := range multisigList {
+			multisigList[i].KeyOrigin = keyOriginList[i]
+		}
+		return nil
+	}
+	for i := range descriptorDataList {
+		if descriptorDataList[i].KeyType == KCfdDescriptorKeyNull {
+			continue
+		}
+		if len(keyOriginList) != 1 {
+			return errors.New("Descriptor key count mismatch.")
+		}
+		descriptorDataList[i].KeyOrigin = keyOriginList[0]
+	}
+	return nil
+}
+
+/**
+ * Create descriptor key string.
+ * param: handle       cfd handle
+ * param: keyData      descriptor key data
+ * param: networkType  network type
+ * param: isWitness    witness script flag (uncompressed pubkey is not allowed)
+ * return: key         descriptor key string
+ * return: pubkeySize  pubkey size in the script
+ * return: err         error
+ */
+func createDescriptorKey(handle uintptr, keyData CfdDescriptorKeyData, networkType NetworkType, isWitness bool) (key string, pubkeySize int, err error) {
+	switch keyData.KeyType {
+	case KCfdDescriptorKeyPublic:
+		pubkey, err := hex.DecodeString(keyData.Pubkey)
+		if err != nil || !isValidPubkey(handle, pubkey) {
+			return "", 0, errors.New("Invalid pubkey.")
+		}
+		if isWitness && len(pubkey) != 33 {
+			return "", 0, errors.New("Uncompressed pubkey is not allowed in the witness script.")
+		}
+		return hex.EncodeToString(pubkey), len(pubkey), nil
+	case KCfdDescriptorKeyBip32, KCfdDescriptorKeyBip32Priv:
+		keyType, extkeyString := KCfdExtPubkey, keyData.ExtPubkey
+		if keyData.KeyType == KCfdDescriptorKeyBip32Priv {
+			keyType, extkeyString = KCfdExtPrivkey, keyData.ExtPrivkey
+		}
+		path := ""
+		if position := strings.IndexByte(extkeyString, '/'); position >= 0 {
+			extkeyString, path = extkeyString[:position], extkeyString[position+1:]
+			if path, err = normalizeDescriptorPath(path, true); err != nil {
+				return "", 0, err
+			}
+		}
+		extkeyData, err := CfdGoGetExtkeyInformation(handle, extkeyString)
+		if err != nil {
+			return "", 0, err
+		}
+		if extkeyData.KeyType != keyType {
+			return "", 0, errors.New("Extkey type mismatch.")
+		}
+		isMainnet := networkType == KCfdNetworkMainnet || networkType == KCfdNetworkLiquidv1
+		if isMainnet != (extkeyData.Network == KCfdNetworkMainnet) {
+			return "", 0, errors.New("Extkey network mismatch.")
+		}
+		key, _ = toBip32Extkey(extkeyString)
+		if path != "" {
+			key += "/" + path
+		}
+		return key, 33, nil
+	default:
+		return "", 0, errors.New("Illegal descriptor key type.")
+	}
+}
+
+/**
+ * Validate descriptor script type list.
+ * param: scriptTypes  script type list (outermost script first)
+ * return: isWitness   witness script flag
+ * return: err         error
+ */
+func validateDescriptorScriptTypes(scriptTypes []DescriptorScriptType) (isWitness bool, err error) {
+	if len(scriptTypes) == 0 {
+		return false, errors.New("Descriptor script type is empty.")
+	}
+	for i, scriptType := range scriptTypes {
+		if err = validateEnumTypes(scriptType); err != nil {
+			return false, err
+		}
+		isTop := i == 0
+		isLast := i == len(scriptTypes)-1
+		parentType := KCfdDescriptorScriptNull
+		if !isTop {
+			parentType = scriptTypes[i-1]
+		}
+		isValid := false
+		switch scriptType {
+		case KCfdDescriptorScriptSh:
+			isValid = isTop && !isLast
+		case KCfdDescriptorScriptWsh:
+			isValid = (isTop || parentType == KCfdDescriptorScriptSh) && !isLast
+			isWitness = true
+		case KCfdDescriptorScriptWpkh:
+			isValid = (isTop || parentType == KCfdDescriptorScriptSh) && isLast
+			isWitness = true
+		case KCfdDescriptorScriptCombo:
+			isValid = isTop && isLast
+		case KCfdDescriptorScriptPk, KCfdDescriptorScriptPkh,
+			KCfdDescriptorScriptMulti, KCfdDescriptorScriptSortedMulti:
+			isValid = isLast
+		default:
+			return false, errors.New("Unsupported descriptor script type.")
+		}
+		if !isValid {
+			return false, errors.New("Invalid descriptor script type order.")
+		}
+	}
+	return isWitness, nil
+}
+
+/**
+ * Create Output Descriptor.
+ * detail: the result is a canonical descriptor with checksum.
+ *   bip32 keys (ExtPubkey, ExtPrivkey) can have the child path. (ex: xpub.../0/*)
+ * param: handle           cfd handle
+ * param: scriptTypes      script type list (outermost script first. ex: sh, wsh, multi)
+ * param: keyDataList      key list (multi and sortedmulti use all keys, other uses one key)
+ * param: keyOriginList    key origin list (nil uses KeyOrigin of keyDataList)
+ * param: requireNum       multisig require signature num (multi and sortedmulti only)
+ * param: networkType      network type
+ * return: descriptor      output descriptor
+ * return: err             error
+ */
+func CfdGoCreateDescriptor(handle uintptr, scriptTypes []DescriptorScriptType, keyDataList []CfdDescriptorKeyData, keyOriginList []CfdDescriptorKeyOrigin, requireNum uint32, networkType NetworkType) (descriptor string, err error) {
+	descriptor, err = createDescriptor(handle, scriptTypes, keyDataList, keyOriginList, requireNum, networkType)
+	if err != nil {
+		return "", convertGoError(err, "CfdGoCreateDescriptor")
+	}
+	return descriptor, nil
+}
+
+/**
+ * Create Output Descriptor. (see: CfdGoCreateDescriptor)
+ * param: handle       cfd handle
+ */
+func createDescriptor(handle uintptr, scriptTypes []DescriptorScriptType, keyDataList []CfdDescriptorKeyData, keyOriginList []CfdDescriptorKeyOrigin, requireNum uint32, networkType NetworkType) (descriptor string, err error) {
+	if err = validateEnumTypes(networkType); err != nil {
+		return "", err
+	}
+	isWitness, err := validateDescriptorScriptTypes(scriptTypes)
+	if err != nil {
+		return "", err
+	}
+	if keyOriginList != nil && len(keyOriginList) != len(keyDataList) {
+		return "", errors.New("Key origin count mismatch.")
+	}
+
+	innerType := scriptTypes[len(scriptTypes)-1]
+	isMultisig := innerType == KCfdDescriptorScriptMulti || innerType == KCfdDescriptorScriptSortedMulti
+	if isMultisig {
+		if len(keyDataList) == 0 || len(keyDataList) > descriptorMaxMultisigKeyNum {
+			return "", errors.New("Invalid multisig key count.")
+		}
+		if requireNum == 0 || requireNum > uint32(len(keyDataList)) {
+			return "", errors.New("Invalid multisig require num.")
+		}
+	} else if len(keyDataList) != 1 {
+		return "", errors.New("Invalid key count.")
+	}
+
+	keys := make([]string, 0, len(keyDataList)+1)
+	if isMultisig {
+		keys = append(keys, strconv.FormatUint(uint64(requireNum), 10))
+	}
+	// OP_m ... OP_n OP_CHECKMULTISIG
+	scriptSize := 3
+	for i, keyData := range keyDataList {
+		key, pubkeySize, err := createDescriptorKey(handle, keyData, networkType, isWitness)
+		if err != nil {
+			return "", err
+		}
+		keyOrigin := keyData.KeyOrigin
+		if keyOriginList != nil {
+			keyOrigin = keyOriginList[i]
+		}
+		origin, err := createDescriptorKeyOrigin(keyOrigin)
+		if err != nil {
+			return "", err
+		}
+		keys = append(keys, origin+key)
+		scriptSize += 1 + pubkeySize
+	}
+	if isMultisig && !isWitness && scriptTypes[0] == KCfdDescriptorScriptSh && scriptSize > descriptorMaxScriptSize {
+		return "", errors.New("Redeem script size is too large.")
+	}
+
+	descriptor = innerType.String() + "(" + strings.Join(keys, ",") + ")"
+	for i := len(scriptTypes) - 2; i >= 0; i-- {
+		descriptor = scriptTypes[i].String() + "(" + descriptor + ")"
+	}
+	checksum, err := CfdGoGetDescriptorChecksum(handle, descriptor, networkType)
+	if err != nil {
+		return "", err
+	}
+	return descriptor + "#" + checksum, nil
+}
+
+/**
+ * Descriptor range key struct.
+ * detail: the bip32 key is derived up to the parent of the wildcard.
+ */
+type descriptorRangeKey struct {
+	// pubkey (non-wildcard key only)
+	pubkey []byte
+	// parent extkey of the wildcard
+	parentExtkey string
+	// hardened wildcard flag ("*'")
+	isHardened bool
+}
+
+/**
+ * Descriptor range script struct.
+ * detail: parsed form of the output descriptor for the range derivation.
+ */
+type descriptorRangeScript struct {
+	networkType NetworkType
+	// wrapper script names (outermost first. ex: sh, wsh)
+	wrappers []string
+	// inner script name (pk, pkh, wpkh, multi, sortedmulti, addr, raw)
+	name       string
+	requireNum int
+	keys       []descriptorRangeKey
+	// fixed script (addr, raw)
+	lockingScript string
+	address       string
+}
+
+/**
+ * Parse descriptor range key.
+ * param: handle       cfd handle
+ * param: key          descriptor key string (ex: [d34db33f/84'/0'/0']xpub.../0/*)
+ * param: networkType  network type
+ * return: rangeKey    range key
+ * return: err         error
+ */
+func parseDescriptorRangeKey(handle uintptr, key string, networkType NetworkType) (rangeKey descriptorRangeKey, err error) {
+	if strings.HasPrefix(key, "[") {
+		end := strings.IndexByte(key, ']')
+		if end < 0 {
+			return rangeKey, errors.New("Invalid key origin.")
+		}
+		key = key[end+1:]
+	}
+	if pubkey, err := hex.DecodeString(key); err == nil {
+		rangeKey.pubkey = pubkey
+		return rangeKey, nil
+	}
+
+	extkeyString, path := key, ""
+	if position := strings.IndexByte(key, '/'); position >= 0 {
+		extkeyString, path = key[:position], key[position+1:]
+		if path, err = normalizeDescriptorPath(path, true); err != nil {
+			return rangeKey, err
+		}
+	}
+	extkeyData, err := CfdGoGetExtkeyInformation(handle, extkeyString)
+	if err != nil {
+		return rangeKey, err
+	}
+	isWildcard := false
+	if strings.HasSuffix(path, "*") || strings.HasSuffix(path, "*'") {
+		isWildcard = true
+		rangeKey.isHardened = strings.HasSuffix(path, "'")
+		path = strings.TrimSuffix(path[:strings.LastIndexByte(path, '*')], "/")
+	}
+	if path != "" {
+		extkeyString, err = CfdGoCreateExtkeyFromParentPath(handle, extkeyString, path, networkType, extkeyData.KeyType)
+		if err != nil {
+			return rangeKey, err
+		}
+	}
+	if isWildcard {
+		rangeKey.parentExtkey = extkeyString
+		return rangeKey, nil
+	}
+	pubkey, err := CfdGoGetPubkeyFromExtkey(handle, extkeyString, networkType)
+	if err == nil {
+		rangeKey.pubkey, err = hex.DecodeString(pubkey)
+	}
+	return rangeKey, err
+}
+
+/**
+ * Get pubkey of the descriptor range key.
+ * param: handle       cfd handle
+ * param: networkType  network type
+ * param: path         derivation path of the wildcard (ex: 0)
+ * return: pubkey      pubkey
+ * return: err         error
+ */
+func (k descriptorRangeKey) getPubkey(handle uintptr, networkType NetworkType, path string) (pubkey []byte, err error) {
+	if k.parentExtkey == "" {
+		return k.pubkey, nil
+	}
+	keyType := KCfdExtPubkey
+	if k.isHardened {
+		path += "'"
+		keyType = KCfdExtPrivkey
+	}
+	extkey, err := CfdGoCreateExtkeyFromParentPath(handle, k.parentExtkey, path, networkType, keyType)
+	if err != nil {
+		return nil, err
+	}
+	pubkeyHex, err := CfdGoGetPubkeyFromExtkey(handle, extkey, networkType)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(pubkeyHex)
+}
+
+/**
+ * Parse descriptor for the range derivation.
+ * detail: the descriptor must be validated by cfd (CfdParseDescriptor) before.
+ * param: handle        cfd handle
+ * param: descriptor    descriptor (without checksum)
+ * param: networkType   network type
+ * param: topData       parsed top data (depth 0) of the descriptor
+ * return: rangeScript  range script
+ * return: err          error
+ */
+func parseDescriptorRangeScript(handle uintptr, descriptor string, networkType NetworkType, topData CfdDescriptorData) (rangeScript *descriptorRangeScript, err error) {
+	rangeScript = &descriptorRangeScript{networkType: networkType}
+	wrappers, name, args, err := splitDescriptorScript(descriptor)
+	if err != nil {
+		return nil, err
+	}
+	rangeScript.wrappers = wrappers
+	rangeScript.name = name
+	switch name {
+	case "addr", "raw":
+		rangeScript.lockingScript = topData.LockingScript
+		rangeScript.address = topData.Address
+		return rangeScript, nil
+	case "multi", "sortedmulti":
+		if rangeScript.requireNum, err = strconv.Atoi(args[0]); err != nil {
+			return nil, errors.New("Invalid multisig require num.")
+		}
+		args = args[1:]
+	case "pk", "pkh", "wpkh":
+	default:
+		return nil, errors.New("Unsupported descriptor script type for range derivation.")
+	}
+	rangeScript.keys = make([]descriptorRangeKey, len(args))
+	for i, key := range args {
+		if rangeScript.keys[i], err = parseDescriptorRangeKey(handle, key, networkType); err != nil {
+			return nil, err
+		}
+	}
+	return rangeScript, nil
+}
+
+/**
+ * Derive locking script and address by the wildcard index.
+ * param: handle          cfd handle
+ * param: index           wildcard index
+ * return: lockingScript  locking script
+ * return: address        address (empty if the script has no address)
+ * return: err            error
+ */
+func (s *descriptorRangeScript) derive(handle uintptr, index uint32) (lockingScript string, address string, err error) {
+	if len(s.keys) == 0 {
+		return s.lockingScript, s.address, nil
+	}
+	pubkeys := make([][]byte, len(s.keys))
+	path := strconv.FormatUint(uint64(index), 10)
+	for i, key := range s.keys {
+		if pubkeys[i], err = key.getPubkey(handle, s.networkType, path); err != nil {
+			return "", "", err
+		}
+	}
+
+	switch s.name {
+	case "pk":
+		script := append([]byte{byte(len(pubkeys[0]))}, pubkeys[0]...)
+		lockingScript = hex.EncodeToString(append(script, opCheckSig))
+	case "pkh", "wpkh":
+		hashType := KCfdP2pkh
+		if s.name == "wpkh" {
+			hashType = KCfdP2wpkh
+		}
+		address, lockingScript, _, err = CfdGoCreateAddress(handle, hashType, hex.EncodeToString(pubkeys[0]), "", s.networkType)
+		if err != nil {
+			return "", "", err
+		}
+	case "multi", "sortedmulti":
+		if s.name == "sortedmulti" {
+			sort.Slice(pubkeys, func(i, j int) bool {
+				return bytes.Compare(pubkeys[i], pubkeys[j]) < 0
+			})
+		}
+		// OP_m <pubkeys> OP_n OP_CHECKMULTISIG
+		script := []byte{op1 - 1 + byte(s.requireNum)}
+		for _, pubkey := range pubkeys {
+			script = append(script, byte(len(pubkey)))
+			script = append(script, pubkey...)
+		}
+		script = append(script, op1-1+byte(len(pubkeys)), opCheckMultiSig)
+		lockingScript = hex.EncodeToString(script)
+	}
+
+	for i := len(s.wrappers) - 1; i >= 0; i-- {
+		hashType := KCfdP2sh
+		if s.wrappers[i] == "wsh" {
+			hashType = KCfdP2wsh
+		}
+		address, lockingScript, _, err = CfdGoCreateAddress(handle, hashType, "", lockingScript, s.networkType)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	return lockingScript, address, nil
+}
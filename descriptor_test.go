@@ -0,0 +1,298 @@
+package cfdgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdDescriptorChecksum(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	descriptor := "pkh(02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5)"
+	checksum, err := CfdGoGetDescriptorChecksum(handle, descriptor, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "8fhd9pwu", checksum)
+
+	outputDescriptor, err := CfdGoAppendDescriptorChecksum(handle, descriptor, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, descriptor+"#8fhd9pwu", outputDescriptor)
+	err = CfdGoVerifyDescriptorChecksum(handle, outputDescriptor, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+
+	// existing checksum
+	outputDescriptor, err = CfdGoAppendDescriptorChecksum(handle, outputDescriptor, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, descriptor+"#8fhd9pwu", outputDescriptor)
+	checksum, err = CfdGoGetDescriptorChecksum(handle, descriptor+"#qqqqqqqq", KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "8fhd9pwu", checksum)
+
+	descriptor = "wpkh([d34db33f/84h/0h/0h]xpub6DJ2dNUysrn5Vt36jH2KLBT2i1auw1tTSSomg8PhqNiUtx8QX2SvC9nrHu81fT41fvDUnhMjEzQgXnQjKEu3oaqMSzhSrHMxyyoEAmUHQbY/0/*)"
+	outputDescriptor, err = CfdGoAppendDescriptorChecksum(handle, descriptor, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, descriptor+"#cjjspncu", outputDescriptor)
+
+	// checksum mismatch
+	err = CfdGoVerifyDescriptorChecksum(handle, descriptor+"#cjjspncv", KCfdNetworkMainnet)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidDescriptorChecksum))
+	assert.Contains(t, err.Error(), "Invalid descriptor checksum.")
+	_, err = CfdGoAppendDescriptorChecksum(handle, descriptor+"#cjjspnc", KCfdNetworkMainnet)
+	assert.True(t, errors.Is(err, ErrInvalidDescriptorChecksum))
+	_, _, err = CfdGoParseDescriptor(handle, descriptor+"#cjjspncv", KCfdNetworkMainnet, "0")
+	assert.True(t, errors.Is(err, ErrInvalidDescriptorChecksum))
+
+	// other error
+	err = CfdGoVerifyDescriptorChecksum(handle, descriptor, KCfdNetworkMainnet)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrInvalidDescriptorChecksum))
+	assert.Contains(t, err.Error(), "Descriptor checksum is not found.")
+	_, err = CfdGoGetDescriptorChecksum(handle, "pkh(あ)", KCfdNetworkMainnet)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrInvalidDescriptorChecksum))
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdDescriptorChecksum test done.\n")
+}
+
+func TestCfdCreateDescriptor(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	pubkey1 := "0205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed87106"
+	pubkey2 := "02c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e75144774"
+	xpub := "xpub6DJ2dNUysrn5Vt36jH2KLBT2i1auw1tTSSomg8PhqNiUtx8QX2SvC9nrHu81fT41fvDUnhMjEzQgXnQjKEu3oaqMSzhSrHMxyyoEAmUHQbY"
+
+	// p2sh-p2wsh multisig
+	descriptor, err := CfdGoCreateDescriptor(handle,
+		[]DescriptorScriptType{KCfdDescriptorScriptSh, KCfdDescriptorScriptWsh, KCfdDescriptorScriptMulti},
+		[]CfdDescriptorKeyData{
+			{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1},
+			{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey2},
+		},
+		[]CfdDescriptorKeyOrigin{
+			{Fingerprint: "d34db33f", DerivationPath: "m/48'/0'/0'/2'"},
+			{Fingerprint: "5C9E228D", DerivationPath: "48h/0h/0h/2h"},
+		},
+		2, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "sh(wsh(multi(2,[d34db33f/48'/0'/0'/2']"+pubkey1+",[5c9e228d/48'/0'/0'/2']"+pubkey2+")))#9pzd5cue", descriptor)
+	assert.NoError(t, CfdGoVerifyDescriptorChecksum(handle, descriptor, KCfdNetworkMainnet))
+
+	// bip32 key with child path (SLIP-132 key is converted)
+	zpub := "zpub6rxZEhppBDs3CURLPzbZkMe33wsopFsTGfrDEvBUbPUF19ks2Ln3SH78LK3BfGMrVCT6HeYrAK7nJMdrkdj5Q4CZBg6J26zwXRvWwtzrWqR"
+	descriptor, err = CfdGoCreateDescriptor(handle,
+		[]DescriptorScriptType{KCfdDescriptorScriptWpkh},
+		[]CfdDescriptorKeyData{{KeyType: KCfdDescriptorKeyBip32, ExtPubkey: zpub + "/0/*"}},
+		[]CfdDescriptorKeyOrigin{{Fingerprint: "d34db33f", DerivationPath: "84h/0h/0h"}},
+		0, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "wpkh([d34db33f/84'/0'/0']"+xpub+"/0/*)#trd0mf0l", descriptor)
+
+	// sortedmulti without key origin
+	descriptor, err = CfdGoCreateDescriptor(handle,
+		[]DescriptorScriptType{KCfdDescriptorScriptSortedMulti},
+		[]CfdDescriptorKeyData{
+			{KeyType: KCfdDescriptorKeyBip32, ExtPubkey: "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*"},
+			{KeyType: KCfdDescriptorKeyBip32, ExtPubkey: "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*"},
+		},
+		nil, 1, KCfdNetworkLiquidv1)
+	assert.NoError(t, err)
+	assert.Equal(t, "sortedmulti(1,xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*,xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*)#z69mwwjk", descriptor)
+
+	// error
+	keyDataList := []CfdDescriptorKeyData{{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1}}
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptWpkh, KCfdDescriptorScriptPkh}, keyDataList, nil, 0, KCfdNetworkMainnet)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid descriptor script type order.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptWsh, KCfdDescriptorScriptSh, KCfdDescriptorScriptPkh}, keyDataList, nil, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Invalid descriptor script type order.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptRaw}, keyDataList, nil, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Unsupported descriptor script type.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptMulti}, keyDataList, nil, 2, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Invalid multisig require num.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptPkh}, keyDataList, []CfdDescriptorKeyOrigin{{DerivationPath: "0'"}}, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Key origin fingerprint is required.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptPkh}, keyDataList, []CfdDescriptorKeyOrigin{{Fingerprint: "d34db33f", DerivationPath: "0/*"}}, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Invalid derivation path wildcard.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptPkh},
+		[]CfdDescriptorKeyData{{KeyType: KCfdDescriptorKeyBip32, ExtPubkey: xpub + "/0/*"}}, nil, 0, KCfdNetworkTestnet)
+	assert.Contains(t, err.Error(), "Extkey network mismatch.")
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptPkh},
+		[]CfdDescriptorKeyData{{KeyType: KCfdDescriptorKeyBip32, ExtPubkey: xpub + "/*/0"}}, nil, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Invalid derivation path wildcard.")
+	uncompressedPubkey := "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+	_, err = CfdGoCreateDescriptor(handle, []DescriptorScriptType{KCfdDescriptorScriptWpkh},
+		[]CfdDescriptorKeyData{{KeyType: KCfdDescriptorKeyPublic, Pubkey: uncompressedPubkey}}, nil, 0, KCfdNetworkMainnet)
+	assert.Contains(t, err.Error(), "Uncompressed pubkey is not allowed in the witness script.")
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCreateDescriptor test done.\n")
+}
+
+func TestCfdCreateDescriptorRoundTrip(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	descriptor := "wsh(multi(1,xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*,xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*))"
+	descriptorDataList, multisigList, err := CfdGoParseDescriptor(handle, descriptor, KCfdNetworkMainnet, "0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(descriptorDataList))
+	assert.Equal(t, 2, len(multisigList))
+
+	if len(descriptorDataList) == 1 {
+		scriptTypes := []DescriptorScriptType{descriptorDataList[0].ScriptType, KCfdDescriptorScriptMulti}
+		outputDescriptor, err := CfdGoCreateDescriptor(handle, scriptTypes, multisigList, nil, 1, KCfdNetworkMainnet)
+		assert.NoError(t, err)
+		assert.Equal(t, "wsh(multi(1,xpub6BgWskLoyHmAUeKWgUXCGfDdCMRXseEjRCMEMvjkedmHpnvWtpXMaCRm8qcADw9einPR8o2c49ZpeHRZP4uYwGeMU2T63G7uf2Y1qJavrWQ,xpub6EKMC2gSMfKgQJ3iNMZVNB4GLH1Dc4hNPah1iMbbztxdUPRo84MMcTgkPATWNRyzr7WifKrt5VvQi4GEqRwybCP1LHoXBKLN6cB15HuBKPE))#adcymclw", outputDescriptor)
+
+		parsedDataList, parsedMultisigList, err := CfdGoParseDescriptor(handle, outputDescriptor, KCfdNetworkMainnet, "")
+		assert.NoError(t, err)
+		assert.Equal(t, descriptorDataList, parsedDataList)
+		assert.Equal(t, multisigList, parsedMultisigList)
+	}
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCreateDescriptorRoundTrip test done.\n")
+}
+
+func TestCfdDescriptorKeyOrigin(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	pubkey1 := "0205f8f73d8a553ad3287a506dbd53ed176cadeb200c8e4f7d68a001b1aed87106"
+	pubkey2 := "02c04c4e03921809fcbef9a26da2d62b19b2b4eb383b3e6cfaaef6370e75144774"
+	descriptor := "sh(wsh(multi(2,[d34db33f/48'/0'/0'/2']" + pubkey1 + ",[5C9E228D/48h/0h/0h/2h]" + pubkey2 + ")))"
+	descriptorDataList := []CfdDescriptorData{
+		{Depth: 0, ScriptType: KCfdDescriptorScriptSh, IsMultisig: true},
+		{Depth: 1, ScriptType: KCfdDescriptorScriptWsh, IsMultisig: true},
+	}
+	multisigList := []CfdDescriptorKeyData{
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1},
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey2},
+	}
+	err = setDescriptorKeyOrigins(descriptor, descriptorDataList, multisigList)
+	assert.NoError(t, err)
+	assert.Equal(t, CfdDescriptorKeyOrigin{}, descriptorDataList[0].KeyOrigin)
+	assert.Equal(t, "d34db33f", multisigList[0].KeyOrigin.Fingerprint)
+	assert.Equal(t, "48'/0'/0'/2'", multisigList[0].KeyOrigin.DerivationPath)
+	assert.Equal(t, "5c9e228d", multisigList[1].KeyOrigin.Fingerprint)
+	assert.Equal(t, "48'/0'/0'/2'", multisigList[1].KeyOrigin.DerivationPath)
+
+	// round trip
+	outputDescriptor, err := CfdGoCreateDescriptor(handle,
+		[]DescriptorScriptType{KCfdDescriptorScriptSh, KCfdDescriptorScriptWsh, KCfdDescriptorScriptMulti},
+		multisigList, nil, 2, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "sh(wsh(multi(2,[d34db33f/48'/0'/0'/2']"+pubkey1+",[5c9e228d/48'/0'/0'/2']"+pubkey2+")))#9pzd5cue", outputDescriptor)
+
+	// sortedmulti: key list of cfd is the descriptor order.
+	descriptor = "wsh(sortedmulti(1,[5c9e228d/48'/0'/0'/2']" + pubkey2 + ",[d34db33f/48'/0'/0'/2']" + pubkey1 + "))"
+	multisigList = []CfdDescriptorKeyData{
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey2},
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1},
+	}
+	err = setDescriptorKeyOrigins(descriptor, descriptorDataList, multisigList)
+	assert.NoError(t, err)
+	assert.Equal(t, "5c9e228d", multisigList[0].KeyOrigin.Fingerprint)
+	assert.Equal(t, "d34db33f", multisigList[1].KeyOrigin.Fingerprint)
+	// same key is listed twice.
+	descriptor = "wsh(sortedmulti(1,[5c9e228d]" + pubkey1 + ",[d34db33f]" + pubkey1 + "))"
+	multisigList = []CfdDescriptorKeyData{
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1},
+		{KeyType: KCfdDescriptorKeyPublic, Pubkey: pubkey1},
+	}
+	err = setDescriptorKeyOrigins(descriptor, descriptorDataList, multisigList)
+	assert.NoError(t, err)
+	assert.Equal(t, "5c9e228d", multisigList[0].KeyOrigin.Fingerprint)
+	assert.Equal(t, "d34db33f", multisigList[1].KeyOrigin.Fingerprint)
+	// key count mismatch
+	err = setDescriptorKeyOrigins(descriptor, descriptorDataList, multisigList[:1])
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Descriptor key count mismatch.")
+
+	// single key
+	descriptorDataList = []CfdDescriptorData{
+		{Depth: 0, ScriptType: KCfdDescriptorScriptWpkh, KeyType: KCfdDescriptorKeyBip32},
+	}
+	err = setDescriptorKeyOrigins("wpkh([d34db33f]xpub6DJ2dNUysrn5Vt36jH2KLBT2i1auw1tTSSomg8PhqNiUtx8QX2SvC9nrHu81fT41fvDUnhMjEzQgXnQjKEu3oaqMSzhSrHMxyyoEAmUHQbY/0/*)", descriptorDataList, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, CfdDescriptorKeyOrigin{Fingerprint: "d34db33f"}, descriptorDataList[0].KeyOrigin)
+	descriptorDataList[0].KeyOrigin = CfdDescriptorKeyOrigin{}
+	err = setDescriptorKeyOrigins("pkh("+pubkey1+")", descriptorDataList, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, CfdDescriptorKeyOrigin{}, descriptorDataList[0].KeyOrigin)
+
+	// error
+	err = setDescriptorKeyOrigins("pkh([d34db3/0']"+pubkey1+")", descriptorDataList, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid key origin fingerprint.")
+	err = setDescriptorKeyOrigins("pkh([d34db33f/0'/*]"+pubkey1+")", descriptorDataList, nil)
+	assert.Contains(t, err.Error(), "Invalid derivation path wildcard.")
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdDescriptorKeyOrigin test done.\n")
+}
+
+func TestCfdParseDescriptorKeyOrigin(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	descriptor := "wpkh([d34db33f/84'/0'/0']xpub6DJ2dNUysrn5Vt36jH2KLBT2i1auw1tTSSomg8PhqNiUtx8QX2SvC9nrHu81fT41fvDUnhMjEzQgXnQjKEu3oaqMSzhSrHMxyyoEAmUHQbY/0/*)"
+	descriptorDataList, multisigList, err := CfdGoParseDescriptor(handle, descriptor, KCfdNetworkMainnet, "0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(descriptorDataList))
+	assert.Equal(t, 0, len(multisigList))
+	if len(descriptorDataList) == 1 {
+		assert.Equal(t, KCfdDescriptorKeyBip32, descriptorDataList[0].KeyType)
+		assert.Equal(t, "d34db33f", descriptorDataList[0].KeyOrigin.Fingerprint)
+		assert.Equal(t, "84'/0'/0'", descriptorDataList[0].KeyOrigin.DerivationPath)
+	}
+
+	descriptor = "wsh(multi(1,[d34db33f/48'/0'/0'/2']xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB/1/0/*,xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH/0/0/*))"
+	descriptorDataList, multisigList, err = CfdGoParseDescriptor(handle, descriptor, KCfdNetworkMainnet, "0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(descriptorDataList))
+	assert.Equal(t, 2, len(multisigList))
+	if len(multisigList) == 2 {
+		assert.Equal(t, "d34db33f", multisigList[0].KeyOrigin.Fingerprint)
+		assert.Equal(t, "48'/0'/0'/2'", multisigList[0].KeyOrigin.DerivationPath)
+		assert.Equal(t, CfdDescriptorKeyOrigin{}, multisigList[1].KeyOrigin)
+	}
+
+	// sortedmulti
+	xpub1 := "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB"
+	xpub2 := "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH"
+	descriptor = "wsh(sortedmulti(1,[d34db33f/48'/0'/0'/2']" + xpub1 + "/1/0/*,[5c9e228d/48'/0'/0'/2']" + xpub2 + "/0/0/*))"
+	descriptorDataList, multisigList, err = CfdGoParseDescriptor(handle, descriptor, KCfdNetworkMainnet, "0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(descriptorDataList))
+	assert.Equal(t, 2, len(multisigList))
+	fingerprints := map[string]string{}
+	for _, key := range []struct{ xpub, path, fingerprint string }{
+		{xpub1, "1/0/0", "d34db33f"},
+		{xpub2, "0/0/0", "5c9e228d"},
+	} {
+		extkey, err := CfdGoCreateExtkeyFromParentPath(handle, key.xpub, key.path, KCfdNetworkMainnet, KCfdExtPubkey)
+		assert.NoError(t, err)
+		pubkey, err := CfdGoGetPubkeyFromExtkey(handle, extkey, KCfdNetworkMainnet)
+		assert.NoError(t, err)
+		fingerprints[pubkey] = key.fingerprint
+	}
+	for _, keyData := range multisigList {
+		assert.Equal(t, fingerprints[keyData.Pubkey], keyData.KeyOrigin.Fingerprint)
+		assert.Equal(t, "48'/0'/0'/2'", keyData.KeyOrigin.DerivationPath)
+	}
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdParseDescriptorKeyOrigin test done.\n")
+}
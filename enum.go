@@ -0,0 +1,440 @@
+package cfdgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NetworkType is the network type. (KCfdNetworkMainnet, etc...)
+type NetworkType = Enum_SS_CfdNetworkType
+
+// HashType is the locking script hash type. (KCfdP2pkh, etc...)
+type HashType = Enum_SS_CfdHashType
+
+// AddressType is the address type. (KCfdP2pkhAddress, etc...)
+type AddressType = Enum_SS_CfdAddressType
+
+// SigHashType is the signature hash type. (KCfdSigHashAll, etc...)
+type SigHashType = Enum_SS_CfdSighashType
+
+// DescriptorScriptType is the output descriptor script type. (KCfdDescriptorScriptSh, etc...)
+type DescriptorScriptType = Enum_SS_CfdDescriptorScriptType
+
+// DescriptorKeyType is the output descriptor key type. (KCfdDescriptorKeyPublic, etc...)
+type DescriptorKeyType = Enum_SS_CfdDescriptorKeyType
+
+// ExtKeyType is the extended key type. (KCfdExtPrivkey, etc...)
+type ExtKeyType = Enum_SS_CfdExtKeyType
+
+/**
+ * Enum type validator.
+ */
+type cfdEnumType interface {
+	validate() error
+}
+
+/**
+ * Validate enum values.
+ * param: values       enum values
+ * return: err         first validation error
+ */
+func validateEnumTypes(values ...cfdEnumType) (err error) {
+	for _, value := range values {
+		if err = value.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Get enum value name.
+ * param: value        enum value
+ * param: valueList    enum value list
+ * param: nameList     enum name list (same order as valueList)
+ * return: name        name (empty if value is not found)
+ */
+func getEnumName(value int, valueList []int, nameList []string) string {
+	for i, target := range valueList {
+		if target == value {
+			return nameList[i]
+		}
+	}
+	return ""
+}
+
+/**
+ * Parse enum value name. (case insensitive)
+ * param: name         enum name
+ * param: valueList    enum value list
+ * param: nameList     enum name list (same order as valueList)
+ * return: value       enum value
+ * return: ok          found flag
+ */
+func parseEnumName(name string, valueList []int, nameList []string) (value int, ok bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, target := range nameList {
+		if target != "" && target == name {
+			return valueList[i], true
+		}
+	}
+	return 0, false
+}
+
+var networkTypeNames = []string{
+	"mainnet", "testnet", "regtest", "liquidv1", "elementsregtest", "customchain"}
+
+func networkTypeValues() []int {
+	return []int{
+		int(KCfdNetworkMainnet), int(KCfdNetworkTestnet), int(KCfdNetworkRegtest),
+		int(KCfdNetworkLiquidv1), int(KCfdNetworkElementsRegtest), int(KCfdNetworkCustomChain)}
+}
+
+/**
+ * Get network type name.
+ * return: name        network type name
+ */
+func (n NetworkType) String() string {
+	if name := getEnumName(int(n), networkTypeValues(), networkTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("NetworkType(%d)", int(n))
+}
+
+/**
+ * Check network type is valid.
+ * return: isValid     valid flag
+ */
+func (n NetworkType) Valid() bool {
+	return getEnumName(int(n), networkTypeValues(), networkTypeNames) != ""
+}
+
+/**
+ * Check network type is elements network.
+ * return: isElements  elements network flag
+ */
+func (n NetworkType) IsElements() bool {
+	if n == KCfdNetworkCustomChain {
+		params := getCustomNetworkParams()
+		return params != nil && params.IsElements
+	}
+	return n == KCfdNetworkLiquidv1 || n == KCfdNetworkElementsRegtest
+}
+
+func (n NetworkType) validate() error {
+	if !n.Valid() {
+		return errors.New("Illegal network type.")
+	}
+	return nil
+}
+
+/**
+ * Parse network type name.
+ * param: name         network type name (mainnet, testnet, regtest, liquidv1, elementsregtest, customchain)
+ * return: networkType network type
+ * return: err         error
+ */
+func ParseNetworkType(name string) (networkType NetworkType, err error) {
+	value, ok := parseEnumName(name, networkTypeValues(), networkTypeNames)
+	if !ok {
+		return networkType, convertGoError(errors.New("Illegal network type."), "ParseNetworkType")
+	}
+	return NetworkType(value), nil
+}
+
+// KCfdTaproot is the taproot (segwit v1) hash type. (cfd: kCfdTaproot)
+// Only the address apis accept it.
+const KCfdTaproot HashType = 7
+
+var hashTypeNames = []string{
+	"p2sh", "p2pkh", "p2wsh", "p2wpkh", "p2sh-p2wsh", "p2sh-p2wpkh", "taproot"}
+
+func hashTypeValues() []int {
+	return []int{
+		int(KCfdP2sh), int(KCfdP2pkh), int(KCfdP2wsh),
+		int(KCfdP2wpkh), int(KCfdP2shP2wsh), int(KCfdP2shP2wpkh), int(KCfdTaproot)}
+}
+
+/**
+ * Get hash type name.
+ * return: name        hash type name
+ */
+func (h HashType) String() string {
+	if name := getEnumName(int(h), hashTypeValues(), hashTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("HashType(%d)", int(h))
+}
+
+/**
+ * Check hash type is valid.
+ * return: isValid     valid flag
+ */
+func (h HashType) Valid() bool {
+	return getEnumName(int(h), hashTypeValues(), hashTypeNames) != ""
+}
+
+func (h HashType) validate() error {
+	if h == KCfdTaproot {
+		return errors.New("Taproot hash type is not supported.")
+	} else if !h.Valid() {
+		return errors.New("Illegal hash type.")
+	}
+	return nil
+}
+
+/**
+ * Parse hash type name.
+ * param: name         hash type name (p2sh, p2pkh, p2wsh, p2wpkh, p2sh-p2wsh, p2sh-p2wpkh, taproot)
+ * return: hashType    hash type
+ * return: err         error
+ */
+func ParseHashType(name string) (hashType HashType, err error) {
+	value, ok := parseEnumName(name, hashTypeValues(), hashTypeNames)
+	if !ok {
+		return hashType, convertGoError(errors.New("Illegal hash type."), "ParseHashType")
+	}
+	return HashType(value), nil
+}
+
+var addressTypeNames = []string{
+	"p2sh", "p2pkh", "p2wsh", "p2wpkh", "p2sh-p2wsh", "p2sh-p2wpkh"}
+
+func addressTypeValues() []int {
+	return []int{
+		int(KCfdP2shAddress), int(KCfdP2pkhAddress), int(KCfdP2wshAddress),
+		int(KCfdP2wpkhAddress), int(KCfdP2shP2wshAddress), int(KCfdP2shP2wpkhAddress)}
+}
+
+/**
+ * Get address type name.
+ * return: name        address type name
+ */
+func (a AddressType) String() string {
+	if name := getEnumName(int(a), addressTypeValues(), addressTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("AddressType(%d)", int(a))
+}
+
+/**
+ * Check address type is valid.
+ * return: isValid     valid flag
+ */
+func (a AddressType) Valid() bool {
+	return getEnumName(int(a), addressTypeValues(), addressTypeNames) != ""
+}
+
+func (a AddressType) validate() error {
+	if !a.Valid() {
+		return errors.New("Illegal address type.")
+	}
+	return nil
+}
+
+/**
+ * Parse address type name.
+ * param: name         address type name (p2sh, p2pkh, p2wsh, p2wpkh, p2sh-p2wsh, p2sh-p2wpkh)
+ * return: addressType address type
+ * return: err         error
+ */
+func ParseAddressType(name string) (addressType AddressType, err error) {
+	value, ok := parseEnumName(name, addressTypeValues(), addressTypeNames)
+	if !ok {
+		return addressType, convertGoError(errors.New("Illegal address type."), "ParseAddressType")
+	}
+	return AddressType(value), nil
+}
+
+var sigHashTypeNames = []string{"all", "none", "single"}
+
+func sigHashTypeValues() []int {
+	return []int{int(KCfdSigHashAll), int(KCfdSigHashNone), int(KCfdSigHashSingle)}
+}
+
+/**
+ * Get sighash type name.
+ * return: name        sighash type name
+ */
+func (s SigHashType) String() string {
+	if name := getEnumName(int(s), sigHashTypeValues(), sigHashTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("SigHashType(%d)", int(s))
+}
+
+/**
+ * Check sighash type is valid.
+ * return: isValid     valid flag
+ */
+func (s SigHashType) Valid() bool {
+	return getEnumName(int(s), sigHashTypeValues(), sigHashTypeNames) != ""
+}
+
+func (s SigHashType) validate() error {
+	if !s.Valid() {
+		return errors.New("Illegal sighash type.")
+	}
+	return nil
+}
+
+/**
+ * Parse sighash type name.
+ * param: name         sighash type name (all, none, single)
+ * return: sighashType sighash type
+ * return: err         error
+ */
+func ParseSigHashType(name string) (sighashType SigHashType, err error) {
+	value, ok := parseEnumName(name, sigHashTypeValues(), sigHashTypeNames)
+	if !ok {
+		return sighashType, convertGoError(errors.New("Illegal sighash type."), "ParseSigHashType")
+	}
+	return SigHashType(value), nil
+}
+
+var descriptorScriptTypeNames = []string{
+	"null", "sh", "wsh", "pk", "pkh", "wpkh", "combo", "multi", "sortedmulti", "addr", "raw"}
+
+func descriptorScriptTypeValues() []int {
+	return []int{
+		int(KCfdDescriptorScriptNull), int(KCfdDescriptorScriptSh), int(KCfdDescriptorScriptWsh),
+		int(KCfdDescriptorScriptPk), int(KCfdDescriptorScriptPkh), int(KCfdDescriptorScriptWpkh),
+		int(KCfdDescriptorScriptCombo), int(KCfdDescriptorScriptMulti),
+		int(KCfdDescriptorScriptSortedMulti), int(KCfdDescriptorScriptAddr),
+		int(KCfdDescriptorScriptRaw)}
+}
+
+/**
+ * Get descriptor script type name.
+ * return: name        descriptor script type name
+ */
+func (d DescriptorScriptType) String() string {
+	if name := getEnumName(int(d), descriptorScriptTypeValues(), descriptorScriptTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("DescriptorScriptType(%d)", int(d))
+}
+
+/**
+ * Check descriptor script type is valid.
+ * return: isValid     valid flag
+ */
+func (d DescriptorScriptType) Valid() bool {
+	return getEnumName(int(d), descriptorScriptTypeValues(), descriptorScriptTypeNames) != ""
+}
+
+func (d DescriptorScriptType) validate() error {
+	if !d.Valid() {
+		return errors.New("Illegal descriptor script type.")
+	}
+	return nil
+}
+
+/**
+ * Parse descriptor script type name.
+ * param: name         descriptor script type name (sh, wsh, pk, pkh, wpkh, combo, multi, etc...)
+ * return: scriptType  descriptor script type
+ * return: err         error
+ */
+func ParseDescriptorScriptType(name string) (scriptType DescriptorScriptType, err error) {
+	value, ok := parseEnumName(name, descriptorScriptTypeValues(), descriptorScriptTypeNames)
+	if !ok {
+		return scriptType, convertGoError(errors.New("Illegal descriptor script type."), "ParseDescriptorScriptType")
+	}
+	return DescriptorScriptType(value), nil
+}
+
+var descriptorKeyTypeNames = []string{"null", "pubkey", "bip32", "bip32priv"}
+
+func descriptorKeyTypeValues() []int {
+	return []int{
+		int(KCfdDescriptorKeyNull), int(KCfdDescriptorKeyPublic),
+		int(KCfdDescriptorKeyBip32), int(KCfdDescriptorKeyBip32Priv)}
+}
+
+/**
+ * Get descriptor key type name.
+ * return: name        descriptor key type name
+ */
+func (d DescriptorKeyType) String() string {
+	if name := getEnumName(int(d), descriptorKeyTypeValues(), descriptorKeyTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("DescriptorKeyType(%d)", int(d))
+}
+
+/**
+ * Check descriptor key type is valid.
+ * return: isValid     valid flag
+ */
+func (d DescriptorKeyType) Valid() bool {
+	return getEnumName(int(d), descriptorKeyTypeValues(), descriptorKeyTypeNames) != ""
+}
+
+func (d DescriptorKeyType) validate() error {
+	if !d.Valid() {
+		return errors.New("Illegal descriptor key type.")
+	}
+	return nil
+}
+
+/**
+ * Parse descriptor key type name.
+ * param: name         descriptor key type name (pubkey, bip32, bip32priv)
+ * return: keyType     descriptor key type
+ * return: err         error
+ */
+func ParseDescriptorKeyType(name string) (keyType DescriptorKeyType, err error) {
+	value, ok := parseEnumName(name, descriptorKeyTypeValues(), descriptorKeyTypeNames)
+	if !ok {
+		return keyType, convertGoError(errors.New("Illegal descriptor key type."), "ParseDescriptorKeyType")
+	}
+	return DescriptorKeyType(value), nil
+}
+
+var extKeyTypeNames = []string{"privkey", "pubkey"}
+
+func extKeyTypeValues() []int {
+	return []int{int(KCfdExtPrivkey), int(KCfdExtPubkey)}
+}
+
+/**
+ * Get extkey type name.
+ * return: name        extkey type name
+ */
+func (e ExtKeyType) String() string {
+	if name := getEnumName(int(e), extKeyTypeValues(), extKeyTypeNames); name != "" {
+		return name
+	}
+	return fmt.Sprintf("ExtKeyType(%d)", int(e))
+}
+
+/**
+ * Check extkey type is valid.
+ * return: isValid     valid flag
+ */
+func (e ExtKeyType) Valid() bool {
+	return getEnumName(int(e), extKeyTypeValues(), extKeyTypeNames) != ""
+}
+
+func (e ExtKeyType) validate() error {
+	if !e.Valid() {
+		return errors.New("Illegal extkey type.")
+	}
+	return nil
+}
+
+/**
+ * Parse extkey type name.
+ * param: name         extkey type name (privkey, pubkey)
+ * return: keyType     extkey type
+ * return: err         error
+ */
+func ParseExtKeyType(name string) (keyType ExtKeyType, err error) {
+	value, ok := parseEnumName(name, extKeyTypeValues(), extKeyTypeNames)
+	if !ok {
+		return keyType, convertGoError(errors.New("Illegal extkey type."), "ParseExtKeyType")
+	}
+	return ExtKeyType(value), nil
+}
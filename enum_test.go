@@ -0,0 +1,80 @@
+package cfdgo
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCfdEnumType(t *testing.T) {
+	assert.Equal(t, "liquidv1", KCfdNetworkLiquidv1.String())
+	assert.Equal(t, "NetworkType(200)", NetworkType(200).String())
+	assert.True(t, KCfdNetworkElementsRegtest.Valid())
+	assert.True(t, KCfdNetworkElementsRegtest.IsElements())
+	assert.False(t, KCfdNetworkRegtest.IsElements())
+	assert.False(t, NetworkType(200).Valid())
+	networkType, err := ParseNetworkType("ElementsRegtest")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdNetworkElementsRegtest, networkType)
+	_, err = ParseNetworkType("signet")
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+	assert.Equal(t, "CFD Error: message=[Illegal network type.], code=[1], operation=[ParseNetworkType]", err.Error())
+
+	assert.Equal(t, "p2sh-p2wpkh", KCfdP2shP2wpkh.String())
+	hashType, err := ParseHashType("p2wsh")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdP2wsh, hashType)
+	assert.False(t, HashType(200).Valid())
+
+	assert.Equal(t, "p2pkh", KCfdP2pkhAddress.String())
+	addressType, err := ParseAddressType("P2SH-P2WSH")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdP2shP2wshAddress, addressType)
+	addressType, err = ParseAddressType("p2wpkh")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdP2wpkhAddress, addressType)
+	_, err = ParseAddressType("taproot")
+	assert.Error(t, err)
+	assert.Equal(t, "AddressType(200)", AddressType(200).String())
+
+	assert.Equal(t, "single", KCfdSigHashSingle.String())
+	sighashType, err := ParseSigHashType("all")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdSigHashAll, sighashType)
+	assert.Equal(t, "SigHashType(0)", SigHashType(0).String())
+	_, err = ParseSigHashType("anyonecanpay")
+	assert.Error(t, err)
+
+	assert.Equal(t, "sortedmulti", KCfdDescriptorScriptSortedMulti.String())
+	scriptType, err := ParseDescriptorScriptType("wpkh")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdDescriptorScriptWpkh, scriptType)
+
+	assert.Equal(t, "bip32priv", KCfdDescriptorKeyBip32Priv.String())
+	keyType, err := ParseDescriptorKeyType("pubkey")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdDescriptorKeyPublic, keyType)
+
+	assert.Equal(t, "privkey", KCfdExtPrivkey.String())
+	extKeyType, err := ParseExtKeyType("pubkey")
+	assert.NoError(t, err)
+	assert.Equal(t, KCfdExtPubkey, extKeyType)
+
+	// validate on go layer
+	_, _, _, err = CfdGoCreateAddress(uintptr(0), KCfdP2pkh, "", "", NetworkType(200))
+	assert.Error(t, err)
+	assert.Equal(t, "CFD Error: message=[Illegal network type.], code=[1], operation=[CfdGoCreateAddress]", err.Error())
+	_, _, _, err = CfdGoCreateAddress(uintptr(0), HashType(200), "", "", KCfdNetworkMainnet)
+	assert.Equal(t, "CFD Error: message=[Illegal hash type.], code=[1], operation=[CfdGoCreateAddress]", err.Error())
+	_, err = CfdGoCreateSighash(uintptr(0), "", "", 0, KCfdP2pkh, "", "", 0, SigHashType(0x81), false)
+	assert.Equal(t, "CFD Error: message=[Illegal sighash type.], code=[1], operation=[CfdGoCreateSighash]", err.Error())
+	// taproot is accepted only by the golang address apis
+	_, _, _, err = CfdGoCreateMultisigScript(uintptr(0), KCfdNetworkMainnet, KCfdTaproot, []string{}, 1)
+	assert.Equal(t, "CFD Error: message=[Taproot hash type is not supported.], code=[1], operation=[CfdGoCreateMultisigScript]", err.Error())
+	_, err = CfdGoCreateConfidentialSighash(uintptr(0), "", "", 0, KCfdTaproot, "", "", 0, "", KCfdSigHashAll, false)
+	assert.Equal(t, "CFD Error: message=[Taproot hash type is not supported.], code=[1], operation=[CfdGoCreateConfidentialSighash]", err.Error())
+	assert.True(t, KCfdTaproot.Valid())
+	fmt.Print("TestCfdEnumType test done.\n")
+}
@@ -0,0 +1,340 @@
+package cfdgo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// extkey constants
+const (
+	extkeySize            = 78
+	extkeyFingerprintSize = 4
+)
+
+/**
+ * Extkey version bytes struct.
+ */
+type extkeyVersion struct {
+	networkType NetworkType
+	hashType    HashType
+	privkey     uint32
+	pubkey      uint32
+}
+
+/**
+ * Get extkey version list. (BIP32, SLIP-132)
+ * detail: liquidv1 and elementsregtest use the BIP32 versions of mainnet and
+ *         testnet (elements chainparams are the same as xpub and tpub),
+ *         so they are not listed and their extkey is decoded as mainnet or testnet.
+ *         the registered custom network has the BIP32 version only.
+ * return: versions    extkey version list
+ */
+func getExtkeyVersions() []extkeyVersion {
+	versions := []extkeyVersion{
+		// xprv, xpub
+		{networkType: KCfdNetworkMainnet, hashType: KCfdP2pkh, privkey: 0x0488ade4, pubkey: 0x0488b21e},
+		// yprv, ypub
+		{networkType: KCfdNetworkMainnet, hashType: KCfdP2shP2wpkh, privkey: 0x049d7878, pubkey: 0x049d7cb2},
+		// Yprv, Ypub
+		{networkType: KCfdNetworkMainnet, hashType: KCfdP2shP2wsh, privkey: 0x0295b005, pubkey: 0x0295b43f},
+		// zprv, zpub
+		{networkType: KCfdNetworkMainnet, hashType: KCfdP2wpkh, privkey: 0x04b2430c, pubkey: 0x04b24746},
+		// Zprv, Zpub
+		{networkType: KCfdNetworkMainnet, hashType: KCfdP2wsh, privkey: 0x02aa7a99, pubkey: 0x02aa7ed3},
+		// tprv, tpub
+		{networkType: KCfdNetworkTestnet, hashType: KCfdP2pkh, privkey: 0x04358394, pubkey: 0x043587cf},
+		// uprv, upub
+		{networkType: KCfdNetworkTestnet, hashType: KCfdP2shP2wpkh, privkey: 0x044a4e28, pubkey: 0x044a5262},
+		// Uprv, Upub
+		{networkType: KCfdNetworkTestnet, hashType: KCfdP2shP2wsh, privkey: 0x024285b5, pubkey: 0x024289ef},
+		// vprv, vpub
+		{networkType: KCfdNetworkTestnet, hashType: KCfdP2wpkh, privkey: 0x045f18bc, pubkey: 0x045f1cf6},
+		// Vprv, Vpub
+		{networkType: KCfdNetworkTestnet, hashType: KCfdP2wsh, privkey: 0x02575048, pubkey: 0x02575483},
+	}
+	if params := getCustomNetworkParams(); params != nil {
+		versions = append(versions, extkeyVersion{networkType: KCfdNetworkCustomChain, hashType: KCfdP2pkh,
+			privkey: params.ExtPrivkeyVersion, pubkey: params.ExtPubkeyVersion})
+	}
+	return versions
+}
+
+/**
+ * Get extkey version bytes.
+ * param: networkType  network type (liquidv1 uses mainnet, other elements networks use testnet)
+ * param: keyType      extkey type
+ * param: hashType     hash type (p2pkh and p2sh use the BIP32 version)
+ * return: version     version bytes
+ * return: err         error
+ */
+func getExtkeyVersion(networkType NetworkType, keyType ExtKeyType, hashType HashType) (version uint32, err error) {
+	if err = validateEnumTypes(networkType, keyType, hashType); err != nil {
+		return 0, err
+	}
+	if hashType == KCfdP2sh {
+		hashType = KCfdP2pkh
+	}
+	versionNetworkType := KCfdNetworkTestnet
+	if isCustomNetwork(networkType) {
+		versionNetworkType = KCfdNetworkCustomChain
+	} else if networkType == KCfdNetworkMainnet || networkType == KCfdNetworkLiquidv1 {
+		versionNetworkType = KCfdNetworkMainnet
+	}
+	for _, data := range getExtkeyVersions() {
+		if data.networkType != versionNetworkType || data.hashType != hashType {
+			continue
+		}
+		if keyType == KCfdExtPrivkey {
+			return data.privkey, nil
+		}
+		return data.pubkey, nil
+	}
+	return 0, errors.New("Illegal hash type.")
+}
+
+/**
+ * Find the extkey version data.
+ * param: extkeyString  extkey base58 string
+ * return: version      version bytes
+ * return: versionData  extkey version data
+ * return: keyType      extkey type
+ * return: err          error
+ */
+func findExtkeyVersion(extkeyString string) (version uint32, versionData extkeyVersion, keyType ExtKeyType, err error) {
+	data, err := decodeBase58Check(extkeyString)
+	if err != nil {
+		return 0, versionData, keyType, err
+	}
+	if len(data) != extkeySize {
+		return 0, versionData, keyType, errors.New("Invalid extkey size.")
+	}
+	version = binary.BigEndian.Uint32(data[0:4])
+	for _, versionData = range getExtkeyVersions() {
+		if version == versionData.privkey {
+			return version, versionData, KCfdExtPrivkey, nil
+		} else if version == versionData.pubkey {
+			return version, versionData, KCfdExtPubkey, nil
+		}
+	}
+	return 0, versionData, keyType, errors.New("Unknown extkey version.")
+}
+
+/**
+ * Replace the extkey version bytes.
+ * param: extkeyString  extkey base58 string
+ * param: version       version bytes
+ * return: replaced     extkey base58 string
+ * return: err          error
+ */
+func replaceExtkeyVersion(extkeyString string, version uint32) (replaced string, err error) {
+	data, err := decodeBase58Check(extkeyString)
+	if err != nil {
+		return "", err
+	}
+	if len(data) != extkeySize {
+		return "", errors.New("Invalid extkey size.")
+	}
+	binary.BigEndian.PutUint32(data[0:4], version)
+	return encodeBase58Check(data), nil
+}
+
+/**
+ * Convert extkey version.
+ * param: extkeyString  extkey base58 string
+ * param: hashType      hash type of the version
+ * return: converted    converted extkey
+ * return: err          error
+ */
+func convertExtkeyVersion(extkeyString string, hashType HashType) (converted string, err error) {
+	_, versionData, keyType, err := findExtkeyVersion(extkeyString)
+	if err != nil {
+		return "", err
+	}
+	version, err := getExtkeyVersion(versionData.networkType, keyType, hashType)
+	if err != nil {
+		return "", err
+	}
+	return replaceExtkeyVersion(extkeyString, version)
+}
+
+/**
+ * Convert SLIP-132 extkey to BIP32 extkey.
+ * detail: invalid extkey is returned as is.
+ * param: extkeyString  extkey base58 string
+ * return: bip32Extkey  BIP32 extkey (xprv, xpub, tprv, tpub)
+ * return: hashType     hash type of the original version
+ */
+func toBip32Extkey(extkeyString string) (bip32Extkey string, hashType HashType) {
+	_, versionData, _, err := findExtkeyVersion(extkeyString)
+	if err != nil || versionData.hashType == KCfdP2pkh {
+		return extkeyString, KCfdP2pkh
+	}
+	if bip32Extkey, err = convertExtkeyVersion(extkeyString, KCfdP2pkh); err != nil {
+		return extkeyString, KCfdP2pkh
+	}
+	return bip32Extkey, versionData.hashType
+}
+
+/**
+ * Convert extkey to the version supported by the native library.
+ * detail: SLIP-132 extkey is converted to BIP32 extkey,
+ *         and custom network extkey is converted to testnet extkey.
+ *         invalid extkey is returned as is.
+ * param: extkeyString  extkey base58 string
+ * return: nativeExtkey  BIP32 extkey (xprv, xpub, tprv, tpub)
+ * return: hashType      hash type of the original version
+ */
+func toNativeExtkey(extkeyString string) (nativeExtkey string, hashType HashType) {
+	_, versionData, keyType, err := findExtkeyVersion(extkeyString)
+	if err != nil || versionData.networkType != KCfdNetworkCustomChain {
+		return toBip32Extkey(extkeyString)
+	}
+	version, err := getExtkeyVersion(KCfdNetworkTestnet, keyType, KCfdP2pkh)
+	if err == nil {
+		nativeExtkey, err = replaceExtkeyVersion(extkeyString, version)
+	}
+	if err != nil {
+		return extkeyString, KCfdP2pkh
+	}
+	return nativeExtkey, versionData.hashType
+}
+
+/**
+ * Convert extkey of the native library to the version of the network.
+ * param: nativeExtkey  BIP32 extkey
+ * param: networkType   network type (custom network uses the registered version)
+ * param: hashType      hash type of the version
+ * return: extkeyString  extkey base58 string
+ * return: err           error
+ */
+func fromNativeExtkey(nativeExtkey string, networkType NetworkType, hashType HashType) (extkeyString string, err error) {
+	if !isCustomNetwork(networkType) && hashType == KCfdP2pkh {
+		return nativeExtkey, nil
+	}
+	_, _, keyType, err := findExtkeyVersion(nativeExtkey)
+	if err != nil {
+		return "", err
+	}
+	version, err := getExtkeyVersion(networkType, keyType, hashType)
+	if err != nil {
+		return "", err
+	}
+	return replaceExtkeyVersion(nativeExtkey, version)
+}
+
+/**
+ * Extkey data struct.
+ */
+type CfdExtkeyData struct {
+	// version bytes hex
+	Version string
+	// parent fingerprint hex
+	Fingerprint string
+	// chain code hex
+	ChainCode string
+	// depth
+	Depth uint32
+	// child number (hardened key is 0x80000000 or more)
+	ChildNumber uint32
+	// extkey type
+	KeyType ExtKeyType
+	// network type (mainnet, testnet or customchain)
+	Network NetworkType
+	// hash type of the version (p2pkh is BIP32 version. see: SLIP-132)
+	HashType HashType
+	// privkey hex (privkey type) or pubkey hex (pubkey type)
+	Key string
+}
+
+/**
+ * Convert extkey version. (BIP32, SLIP-132)
+ * detail: network and key type are kept.
+ *         p2pkh/p2sh: xpub, tpub
+ *         p2sh-p2wpkh: ypub, upub
+ *         p2sh-p2wsh: Ypub, Upub
+ *         p2wpkh: zpub, vpub
+ *         p2wsh: Zpub, Vpub
+ * param: handle           cfd handle
+ * param: extkey           extkey
+ * param: hashType         hash type of the version
+ * return: convertedExtkey  converted extkey
+ * return: err              error
+ */
+func CfdGoConvertExtkeyVersion(handle uintptr, extkey string, hashType HashType) (convertedExtkey string, err error) {
+	if _, err = CfdGoGetExtkeyInformation(handle, extkey); err == nil {
+		convertedExtkey, err = convertExtkeyVersion(extkey, hashType)
+	}
+	if err != nil {
+		return "", convertGoError(err, "CfdGoConvertExtkeyVersion")
+	}
+	return convertedExtkey, nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+/**
+ * Encode base58 with checksum.
+ * detail: it is used only for the version byte swap of the extkey (SLIP-132)
+ *         and the custom network. the keys and addresses are encoded by cfd.
+ * param: payload      payload bytes
+ * return: encoded     base58check string
+ */
+func encodeBase58Check(payload []byte) (encoded string) {
+	checksum := hash256(payload)
+	data := append(append([]byte{}, payload...), checksum[:4]...)
+	num := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	var result []byte
+	for num.Sign() > 0 {
+		num.DivMod(num, radix, mod)
+		result = append(result, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		result = append(result, base58Alphabet[0])
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result)
+}
+
+/**
+ * Decode base58 with checksum.
+ * detail: see encodeBase58Check.
+ * param: encoded      base58check string
+ * return: payload     payload bytes
+ * return: err         error
+ */
+func decodeBase58Check(encoded string) (payload []byte, err error) {
+	num := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range encoded {
+		index := strings.IndexRune(base58Alphabet, c)
+		if index < 0 {
+			return nil, errors.New("Invalid base58 character.")
+		}
+		num.Mul(num, radix)
+		num.Add(num, big.NewInt(int64(index)))
+	}
+	zeroNum := 0
+	for zeroNum < len(encoded) && encoded[zeroNum] == base58Alphabet[0] {
+		zeroNum++
+	}
+	data := append(make([]byte, zeroNum), num.Bytes()...)
+	if len(data) < 4 {
+		return nil, errors.New("Invalid base58check length.")
+	}
+	payload = data[:len(data)-4]
+	checksum := hash256(payload)
+	if !bytes.Equal(checksum[:4], data[len(data)-4:]) {
+		return nil, errors.New("Invalid base58check checksum.")
+	}
+	return payload, nil
+}
@@ -0,0 +1,177 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdExtkeyInformation(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	rootXprv := "xprv9s21ZrQH143K38XAstQ4D3hCGbgydJgNff6CcwmkrWTBxksb2G4CsqAywJCKbTdywfCpmpJyxqf77iKK1ju1J982iP2PriifaNZLMbyPQCx"
+	xprv := "xprv9tviYANkXM1CY831VtMFKFn6LP6aMHf1kvtCZyTL9YbyMwTR2BSmJaEoqw59BZdQhLSx9ZxyKsRUeCetxA2xZ34eupBqZUsifnWyLJJ16j3"
+	xpub := "xpub67v4wfueMiZVkc7UbutFgPiptQw4kkNs89ooNMrwht8xEjnZZim1rNZHhEdrLejB99fiBdnWNNAB8hmUK7tCo5Ua6UtHzwVLj2Bzpch7vB2"
+
+	data, err := CfdGoGetExtkeyInformation(handle, rootXprv)
+	assert.NoError(t, err)
+	assert.Equal(t, "0488ade4", data.Version)
+	assert.Equal(t, "00000000", data.Fingerprint)
+	assert.Equal(t, "6ba6fe01878a68bd0691da3b0485ff90781b4ba8731dca2364f73bca4cb994b4", data.ChainCode)
+	assert.Equal(t, uint32(0), data.Depth)
+	assert.Equal(t, uint32(0), data.ChildNumber)
+	assert.Equal(t, KCfdExtPrivkey, data.KeyType)
+	assert.Equal(t, KCfdNetworkMainnet, data.Network)
+	assert.Equal(t, "0ff13951c5e144fe83130bca6011df2b0bc3ca7c039dfbc8d7b2cdf612b570a4", data.Key)
+	rootPrivkey := data.Key
+
+	data, err = CfdGoGetExtkeyInformation(handle, xprv)
+	assert.NoError(t, err)
+	assert.Equal(t, "0488ade4", data.Version)
+	assert.Equal(t, "03af54a0", data.Fingerprint)
+	assert.Equal(t, "16ddac07d3c3110f0292136af4bc476323e87b6da49ac0b8eef5bcde17e8a672", data.ChainCode)
+	assert.Equal(t, uint32(1), data.Depth)
+	assert.Equal(t, uint32(0x8000002c), data.ChildNumber)
+	assert.Equal(t, KCfdExtPrivkey, data.KeyType)
+	assert.Equal(t, "a0467585c122e8c2c59d2a10dbe073533cbe887758b05c23f281c9bf873998f6", data.Key)
+
+	extkey, err := CfdGoCreateExtkey(handle, KCfdNetworkMainnet, KCfdExtPrivkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, xprv, extkey)
+	extkey, err = CfdGoCreateExtkeyFromParent(handle, KCfdNetworkLiquidv1, KCfdExtPrivkey, rootPrivkey, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, xprv, extkey)
+
+	data, err = CfdGoGetExtkeyInformation(handle, xpub)
+	assert.NoError(t, err)
+	assert.Equal(t, "0488b21e", data.Version)
+	assert.Equal(t, "03af54a0", data.Fingerprint)
+	assert.Equal(t, uint32(1), data.Depth)
+	assert.Equal(t, uint32(0x8000002c), data.ChildNumber)
+	assert.Equal(t, KCfdExtPubkey, data.KeyType)
+	assert.Equal(t, KCfdNetworkMainnet, data.Network)
+	assert.Equal(t, "03eded97b860b7cb5cbfda9f195151ed65f19f856edce22a94b2c7a1ad9c30aefe", data.Key)
+
+	extkey, err = CfdGoCreateExtkey(handle, KCfdNetworkMainnet, KCfdExtPubkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, xpub, extkey)
+
+	// testnet
+	tpub, err := CfdGoCreateExtkey(handle, KCfdNetworkRegtest, KCfdExtPubkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, "tpub", tpub[0:4])
+	data, err = CfdGoGetExtkeyInformation(handle, tpub)
+	assert.NoError(t, err)
+	assert.Equal(t, "043587cf", data.Version)
+	assert.Equal(t, KCfdNetworkTestnet, data.Network)
+	assert.Equal(t, "03eded97b860b7cb5cbfda9f195151ed65f19f856edce22a94b2c7a1ad9c30aefe", data.Key)
+
+	// error
+	_, err = CfdGoGetExtkeyInformation(handle, xpub[:len(xpub)-1]+"1")
+	assert.Error(t, err)
+	_, err = CfdGoCreateExtkey(handle, KCfdNetworkMainnet, KCfdExtPubkey, "", data.Key, data.ChainCode, 0, 1)
+	assert.Error(t, err)
+	_, err = CfdGoCreateExtkey(handle, KCfdNetworkMainnet, KCfdExtPrivkey, "", data.Key, data.ChainCode, 0, 0)
+	assert.Error(t, err)
+	_, err = CfdGoCreateExtkeyFromParent(handle, KCfdNetworkMainnet, KCfdExtPubkey, rootPrivkey, data.Key, data.ChainCode, 0, 0)
+	assert.Contains(t, err.Error(), "Invalid depth.")
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdExtkeyInformation test done.\n")
+}
+
+func TestCfdExtkeyVersion(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	xpub := "xpub67v4wfueMiZVkc7UbutFgPiptQw4kkNs89ooNMrwht8xEjnZZim1rNZHhEdrLejB99fiBdnWNNAB8hmUK7tCo5Ua6UtHzwVLj2Bzpch7vB2"
+	zpub := "zpub6mabZ1FUf5eTTCViGdTW6ZuqEMDxdzMrxNrEw9eiTttiLwR253696VsZjeZ2LU31xRuKgaydHgsGuGzbkWiEPYqmqAH9Am8KGUKHbnBJDgP"
+	testVectors := []struct {
+		hashType HashType
+		extkey   string
+	}{
+		{KCfdP2shP2wpkh, "ypub6SkLFLaZWQ6ybuJbSGfstUpL4P5WhNNN3GL29kkq5tWqHqbnpNvaUSDRiSbSLZP6YnnWw7P4q2Wj1zP32pJDbKAAxpaiarJpzkFeDAvydxz"},
+		{KCfdP2shP2wsh, "Ypub6deRNaK15MfM2UTyNw8riZA8nB7muj3xMXyh522NTfMEv2AhanJrZZ5MX9YutzbznFrVohPBiEuE99zoL3TAjoGqoHh7zFmptUXWoB7xUnc"},
+		{KCfdP2wpkh, zpub},
+		{KCfdP2wsh, "Zpub6xUggEyvE3Cpsmf6DHvUveFdx9GDrM3TGeVurQvFqfj7y7yvqSURBcjVYMWVtuFvBtyJZAykAuFn2ScN3jsBY2xSfdPYaAbKACbABjjre5f"},
+		{KCfdP2pkh, xpub},
+		{KCfdP2sh, xpub},
+	}
+	for _, vector := range testVectors {
+		extkey, err := CfdGoConvertExtkeyVersion(handle, xpub, vector.hashType)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.extkey, extkey)
+		extkey, err = CfdGoConvertExtkeyVersion(handle, vector.extkey, KCfdP2pkh)
+		assert.NoError(t, err)
+		assert.Equal(t, xpub, extkey)
+	}
+
+	data, err := CfdGoGetExtkeyInformation(handle, zpub)
+	assert.NoError(t, err)
+	assert.Equal(t, "04b24746", data.Version)
+	assert.Equal(t, KCfdExtPubkey, data.KeyType)
+	assert.Equal(t, KCfdNetworkMainnet, data.Network)
+	assert.Equal(t, KCfdP2wpkh, data.HashType)
+	assert.Equal(t, "03eded97b860b7cb5cbfda9f195151ed65f19f856edce22a94b2c7a1ad9c30aefe", data.Key)
+
+	converted, err := CfdGoConvertExtkeyVersion(handle, zpub, KCfdP2wpkh)
+	assert.NoError(t, err)
+	assert.Equal(t, zpub, converted)
+
+	// testnet
+	tpub, err := CfdGoCreateExtkey(handle, KCfdNetworkTestnet, KCfdExtPubkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	vpub, err := CfdGoConvertExtkeyVersion(handle, tpub, KCfdP2wpkh)
+	assert.NoError(t, err)
+	assert.Equal(t, "vpub5UFYLLZp4MUY41jEwCK1GDXpYUeAsWPsHvmMoa5AwsPC8YA74QRtcFF1epigLqRLKsS6ggbPT3T5N8YLsj4BCc7NMoVSq7rNBa4i3UtFeEn", vpub)
+
+	// liquidv1 and elementsregtest use the BIP32 versions of mainnet and testnet
+	liquidExtkey, err := CfdGoCreateExtkey(handle, KCfdNetworkLiquidv1, KCfdExtPubkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	liquidData, err := CfdGoGetExtkeyInformation(handle, liquidExtkey)
+	assert.NoError(t, err)
+	assert.Equal(t, "0488b21e", liquidData.Version)
+	assert.Equal(t, KCfdNetworkMainnet, liquidData.Network)
+	regtestExtkey, err := CfdGoCreateExtkey(handle, KCfdNetworkElementsRegtest, KCfdExtPubkey, data.Fingerprint, data.Key, data.ChainCode, byte(data.Depth), data.ChildNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, tpub, regtestExtkey)
+	liquidZpub, err := CfdGoConvertExtkeyVersion(handle, liquidExtkey, KCfdP2wpkh)
+	assert.NoError(t, err)
+	assert.Equal(t, zpub, liquidZpub)
+
+	_, err = CfdGoConvertExtkeyVersion(handle, zpub, KCfdP2pkh+100)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdExtkeyVersion test done.\n")
+}
+
+func TestCfdExtkeyVersionDerive(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	rootZprv := "zprvAWgYBBk7JR8GjiuQYbyJdDtCcXysWYfNVt8eBjZXcXCx4xW3XaPL7xVFyi7VbGwpkwSSGmW6tANCtHYST8j2tcVET4RF2YMe7pgd8n9uMRM"
+	zprv, err := CfdGoCreateExtkeyFromParentPath(handle, rootZprv, "m/44'", KCfdNetworkMainnet, KCfdExtPrivkey)
+	assert.NoError(t, err)
+	assert.Equal(t, "zprvAYbF9Viapi6AEiRFAbvVjRy6gKPUEXe1b9ve8mF6uZMjU95sXVmtYhZ5tLzKBNwFWcgZeXA6FC8aQmt2PYrz9WRreVagjJWhDEeG7YBJD51", zprv)
+
+	zpub, err := CfdGoCreateExtPubkey(handle, zprv, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "zpub6mabZ1FUf5eTTCViGdTW6ZuqEMDxdzMrxNrEw9eiTttiLwR253696VsZjeZ2LU31xRuKgaydHgsGuGzbkWiEPYqmqAH9Am8KGUKHbnBJDgP", zpub)
+
+	pubkey, err := CfdGoGetPubkeyFromExtkey(handle, zpub, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "03eded97b860b7cb5cbfda9f195151ed65f19f856edce22a94b2c7a1ad9c30aefe", pubkey)
+
+	privkey, _, err := CfdGoGetPrivkeyFromExtkey(handle, zprv, KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "a0467585c122e8c2c59d2a10dbe073533cbe887758b05c23f281c9bf873998f6", privkey)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdExtkeyVersionDerive test done.\n")
+}
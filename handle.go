@@ -0,0 +1,1267 @@
+package cfdgo
+
+import (
+	"io"
+	"runtime"
+	"sync"
+)
+
+/**
+ * Cfd handle owner.
+ * detail: Handle owns a cfd handle created by CfdGoCreateHandle and
+ *         releases it on Close. If Close is never called, the handle
+ *         is released by a finalizer when the Handle is garbage collected.
+ *         Calls on the same Handle are serialized, so the last error
+ *         message always belongs to the failed call.
+ */
+type Handle struct {
+	mutex  sync.Mutex
+	handle uintptr
+}
+
+var _ io.Closer = (*Handle)(nil)
+
+/**
+ * Create cfd handle owner.
+ * return: handle      cfd handle owner. release: Close
+ * return: err         error struct
+ */
+func NewHandle() (handle *Handle, err error) {
+	rawHandle, err := CfdGoCreateHandle()
+	if err != nil {
+		if rawHandle != uintptr(0) {
+			CfdGoFreeHandle(rawHandle)
+		}
+		return nil, err
+	}
+	handle = &Handle{handle: rawHandle}
+	runtime.SetFinalizer(handle, (*Handle).Close)
+	return handle, nil
+}
+
+/**
+ * Free cfd handle.
+ * detail: Close is idempotent; calling it on a closed handle returns nil.
+ * return: err         error struct
+ */
+func (h *Handle) Close() (err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.handle == uintptr(0) {
+		return nil
+	}
+	err = CfdGoFreeHandle(h.handle)
+	h.handle = uintptr(0)
+	runtime.SetFinalizer(h, nil)
+	return err
+}
+
+/**
+ * Run function with raw cfd handle.
+ * detail: The raw handle is valid only during fn. Do not keep it.
+ * param: fn           function with raw cfd handle
+ * return: err         error struct
+ */
+func (h *Handle) Do(fn func(handle uintptr) error) (err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.handle == uintptr(0) {
+		return &CfdError{
+			Code:      KCfdIllegalStateError,
+			Message:   "Handle is already closed.",
+			Operation: "Handle",
+		}
+	}
+	return fn(h.handle)
+}
+
+/**
+ * Get last error message.
+ * return: message     last error message
+ * return: err         error
+ */
+func (h *Handle) GetLastErrorMessage() (message string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		message, err = CfdGoGetLastErrorMessage(handle)
+		return err
+	})
+	return message, err
+}
+
+/**
+ * Create Address. (see: CfdGoCreateAddress)
+ */
+func (h *Handle) CreateAddress(hashType HashType, pubkey string, redeemScript string, networkType NetworkType) (address string, lockingScript string, p2shSegwitLockingScript string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		address, lockingScript, p2shSegwitLockingScript, err = CfdGoCreateAddress(handle, hashType, pubkey, redeemScript, networkType)
+		return err
+	})
+	return address, lockingScript, p2shSegwitLockingScript, err
+}
+
+/**
+ * Create multisig script and address. (see: CfdGoCreateMultisigScript)
+ */
+func (h *Handle) CreateMultisigScript(networkType NetworkType, hashType HashType, pubkeys []string, requireNum uint32) (address string, redeemScript string, witnessScript string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		address, redeemScript, witnessScript, err = CfdGoCreateMultisigScript(handle, networkType, hashType, pubkeys, requireNum)
+		return err
+	})
+	return address, redeemScript, witnessScript, err
+}
+
+/**
+ * Parse Output Descriptor. (see: CfdGoParseDescriptor)
+ */
+func (h *Handle) ParseDescriptor(descriptor string, networkType NetworkType, bip32DerivationPath string) (descriptorDataList []CfdDescriptorData, multisigList []CfdDescriptorKeyData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		descriptorDataList, multisigList, err = CfdGoParseDescriptor(handle, descriptor, networkType, bip32DerivationPath)
+		return err
+	})
+	return descriptorDataList, multisigList, err
+}
+
+/**
+ * Derive Output Descriptor by the index range. (see: CfdGoDeriveDescriptorRange)
+ */
+func (h *Handle) DeriveDescriptorRange(descriptor string, networkType NetworkType, start uint32, end uint32) (rangeDataList []CfdDescriptorRangeData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		rangeDataList, err = CfdGoDeriveDescriptorRange(handle, descriptor, networkType, start, end)
+		return err
+	})
+	return rangeDataList, err
+}
+
+/**
+ * Get multisig pubkeys address. (see: CfdGoGetAddressesFromMultisig)
+ */
+func (h *Handle) GetAddressesFromMultisig(redeemScript string, networkType NetworkType, hashType HashType) (addressList []string, pubkeyList []string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		addressList, pubkeyList, err = CfdGoGetAddressesFromMultisig(handle, redeemScript, networkType, hashType)
+		return err
+	})
+	return addressList, pubkeyList, err
+}
+
+/**
+ * Get initialized confidential transaction. (see: CfdGoInitializeConfidentialTx)
+ */
+func (h *Handle) InitializeConfidentialTx(version uint32, locktime uint32) (txHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txHex, err = CfdGoInitializeConfidentialTx(handle, version, locktime)
+		return err
+	})
+	return txHex, err
+}
+
+/**
+ * Add txin to confidential transaction. (see: CfdGoAddConfidentialTxIn)
+ */
+func (h *Handle) AddConfidentialTxIn(txHex string, txid string, vout uint32, sequence uint32) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddConfidentialTxIn(handle, txHex, txid, vout, sequence)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Add txout to confidential transaction. (see: CfdGoAddConfidentialTxOut)
+ */
+func (h *Handle) AddConfidentialTxOut(txHex string, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddConfidentialTxOut(handle, txHex, asset, satoshiAmount, valueCommitment, address, directLockingScript, nonce)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Update txout of confidential transaction. (see: CfdGoUpdateConfidentialTxOut)
+ */
+func (h *Handle) UpdateConfidentialTxOut(txHex string, index uint32, asset string, satoshiAmount int64, valueCommitment string, address string, directLockingScript string, nonce string) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoUpdateConfidentialTxOut(handle, txHex, index, asset, satoshiAmount, valueCommitment, address, directLockingScript, nonce)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Get confidential transaction data. (see: CfdGoGetConfidentialTxData)
+ */
+func (h *Handle) GetConfidentialTxData(txHex string) (data CfdTxData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoGetConfidentialTxData(handle, txHex)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Get txin on confidential transaction. (see: CfdGoGetConfidentialTxIn)
+ */
+func (h *Handle) GetConfidentialTxIn(txHex string, index uint32) (txid string, vout uint32, sequence uint32, scriptSig string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txid, vout, sequence, scriptSig, err = CfdGoGetConfidentialTxIn(handle, txHex, index)
+		return err
+	})
+	return txid, vout, sequence, scriptSig, err
+}
+
+/**
+ * Get witness stack on confidential transaction input. (see: CfdGoGetConfidentialTxInWitness)
+ */
+func (h *Handle) GetConfidentialTxInWitness(txHex string, txinIndex uint32, stackIndex uint32) (stackData string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		stackData, err = CfdGoGetConfidentialTxInWitness(handle, txHex, txinIndex, stackIndex)
+		return err
+	})
+	return stackData, err
+}
+
+/**
+ * Get txin issuance on confidential transaction. (see: CfdGoGetTxInIssuanceInfo)
+ */
+func (h *Handle) GetTxInIssuanceInfo(txHex string, index uint32) (entropy string, nonce string, assetAmount int64, assetValue string, tokenAmount int64, tokenValue string, assetRangeproof string, tokenRangeproof string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err = CfdGoGetTxInIssuanceInfo(handle, txHex, index)
+		return err
+	})
+	return entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err
+}
+
+/**
+ * Get txout on confidential transaction. (see: CfdGoGetConfidentialTxOut)
+ */
+func (h *Handle) GetConfidentialTxOut(txHex string, index uint32) (asset string, satoshiAmount int64, valueCommitment string, nonce string, lockingScript string, surjectionProof string, rangeproof string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err = CfdGoGetConfidentialTxOut(handle, txHex, index)
+		return err
+	})
+	return asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err
+}
+
+/**
+ * Get txin count on confidential transaction. (see: CfdGoGetConfidentialTxInCount)
+ */
+func (h *Handle) GetConfidentialTxInCount(txHex string) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetConfidentialTxInCount(handle, txHex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get witness stack count on confidential transaction input. (see: CfdGoGetConfidentialTxInWitnessCount)
+ */
+func (h *Handle) GetConfidentialTxInWitnessCount(txHex string, txinIndex uint32) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetConfidentialTxInWitnessCount(handle, txHex, txinIndex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get txout count on confidential transaction. (see: CfdGoGetConfidentialTxOutCount)
+ */
+func (h *Handle) GetConfidentialTxOutCount(txHex string) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetConfidentialTxOutCount(handle, txHex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Initialize transaction data handle. (see: CfdGoInitializeTxDataHandle)
+ */
+func (h *Handle) InitializeTxDataHandle(networkType NetworkType, txHex string) (txDataHandle uintptr, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txDataHandle, err = CfdGoInitializeTxDataHandle(handle, networkType, txHex)
+		return err
+	})
+	return txDataHandle, err
+}
+
+/**
+ * Free transaction data handle. (see: CfdGoFreeTxDataHandle)
+ */
+func (h *Handle) FreeTxDataHandle(txDataHandle uintptr) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoFreeTxDataHandle(handle, txDataHandle)
+	})
+}
+
+/**
+ * Get confidential transaction data from transaction data handle. (see: CfdGoGetConfidentialTxDataByHandle)
+ */
+func (h *Handle) GetConfidentialTxDataByHandle(txDataHandle uintptr) (data CfdTxData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoGetConfidentialTxDataByHandle(handle, txDataHandle)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Get txin from transaction data handle. (see: CfdGoGetTxInByHandle)
+ */
+func (h *Handle) GetTxInByHandle(txDataHandle uintptr, index uint32) (txid string, vout uint32, sequence uint32, scriptSig string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txid, vout, sequence, scriptSig, err = CfdGoGetTxInByHandle(handle, txDataHandle, index)
+		return err
+	})
+	return txid, vout, sequence, scriptSig, err
+}
+
+/**
+ * Get txin issuance from transaction data handle. (see: CfdGoGetTxInIssuanceInfoByHandle)
+ */
+func (h *Handle) GetTxInIssuanceInfoByHandle(txDataHandle uintptr, index uint32) (entropy string, nonce string, assetAmount int64, assetValue string, tokenAmount int64, tokenValue string, assetRangeproof string, tokenRangeproof string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err = CfdGoGetTxInIssuanceInfoByHandle(handle, txDataHandle, index)
+		return err
+	})
+	return entropy, nonce, assetAmount, assetValue, tokenAmount, tokenValue, assetRangeproof, tokenRangeproof, err
+}
+
+/**
+ * Get witness stack count from transaction data handle. (see: CfdGoGetTxInWitnessCountByHandle)
+ */
+func (h *Handle) GetTxInWitnessCountByHandle(txDataHandle uintptr, isPeginWitness bool, txinIndex uint32) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetTxInWitnessCountByHandle(handle, txDataHandle, isPeginWitness, txinIndex)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get witness stack from transaction data handle. (see: CfdGoGetTxInWitnessByHandle)
+ */
+func (h *Handle) GetTxInWitnessByHandle(txDataHandle uintptr, isPeginWitness bool, txinIndex uint32, stackIndex uint32) (stackData string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		stackData, err = CfdGoGetTxInWitnessByHandle(handle, txDataHandle, isPeginWitness, txinIndex, stackIndex)
+		return err
+	})
+	return stackData, err
+}
+
+/**
+ * Get txout from transaction data handle. (see: CfdGoGetConfidentialTxOutByHandle)
+ */
+func (h *Handle) GetConfidentialTxOutByHandle(txDataHandle uintptr, index uint32) (asset string, satoshiAmount int64, valueCommitment string, nonce string, lockingScript string, surjectionProof string, rangeproof string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err = CfdGoGetConfidentialTxOutByHandle(handle, txDataHandle, index)
+		return err
+	})
+	return asset, satoshiAmount, valueCommitment, nonce, lockingScript, surjectionProof, rangeproof, err
+}
+
+/**
+ * Get txin count from transaction data handle. (see: CfdGoGetTxInCountByHandle)
+ */
+func (h *Handle) GetTxInCountByHandle(txDataHandle uintptr) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetTxInCountByHandle(handle, txDataHandle)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get txout count from transaction data handle. (see: CfdGoGetTxOutCountByHandle)
+ */
+func (h *Handle) GetTxOutCountByHandle(txDataHandle uintptr) (count uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		count, err = CfdGoGetTxOutCountByHandle(handle, txDataHandle)
+		return err
+	})
+	return count, err
+}
+
+/**
+ * Get txin index from transaction data handle. (see: CfdGoGetTxInIndexByHandle)
+ */
+func (h *Handle) GetTxInIndexByHandle(txDataHandle uintptr, txid string, vout uint32) (index uint32, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		index, err = CfdGoGetTxInIndexByHandle(handle, txDataHandle, txid, vout)
+		return err
+	})
+	return index, err
+}
+
+/**
+ * Set reissuance asset to confidential transaction. (see: CfdGoSetRawReissueAsset)
+ */
+func (h *Handle) SetRawReissueAsset(txHex string, txid string, vout uint32, assetSatoshiAmount int64, blindingNonce string, entropy string, address string, directLockingScript string) (asset string, outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		asset, outputTxHex, err = CfdGoSetRawReissueAsset(handle, txHex, txid, vout, assetSatoshiAmount, blindingNonce, entropy, address, directLockingScript)
+		return err
+	})
+	return asset, outputTxHex, err
+}
+
+/**
+ * Set new asset issuance to confidential transaction. (see: CfdGoSetRawIssueAsset)
+ */
+func (h *Handle) SetRawIssueAsset(txHex string, txid string, vout uint32, contractHash string, assetSatoshiAmount int64, assetAddress string, assetLockingScript string, tokenSatoshiAmount int64, tokenAddress string, tokenLockingScript string, isBlindAsset bool) (entropy string, asset string, token string, outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		entropy, asset, token, outputTxHex, err = CfdGoSetRawIssueAsset(handle, txHex, txid, vout, contractHash, assetSatoshiAmount, assetAddress, assetLockingScript, tokenSatoshiAmount, tokenAddress, tokenLockingScript, isBlindAsset)
+		return err
+	})
+	return entropy, asset, token, outputTxHex, err
+}
+
+/**
+ * Get issuance blinding key. (see: CfdGoGetIssuanceBlindingKey)
+ */
+func (h *Handle) GetIssuanceBlindingKey(masterBlindingKey string, txid string, vout uint32) (blindingKey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		blindingKey, err = CfdGoGetIssuanceBlindingKey(handle, masterBlindingKey, txid, vout)
+		return err
+	})
+	return blindingKey, err
+}
+
+/**
+ * Get blind transaction handle. (see: CfdGoInitializeBlindTx)
+ * detail: blindHandle must be released by FreeBlindHandle on this Handle.
+ */
+func (h *Handle) InitializeBlindTx() (blindHandle uintptr, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		blindHandle, err = CfdGoInitializeBlindTx(handle)
+		return err
+	})
+	return blindHandle, err
+}
+
+/**
+ * Add blind transaction txin data. (see: CfdGoAddBlindTxInData)
+ */
+func (h *Handle) AddBlindTxInData(blindHandle uintptr, txid string, vout uint32, asset string, assetBlindFactor string, valueBlindFactor string, satoshiAmount int64, assetKey string, tokenKey string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddBlindTxInData(handle, blindHandle, txid, vout, asset, assetBlindFactor, valueBlindFactor, satoshiAmount, assetKey, tokenKey)
+	})
+}
+
+/**
+ * Add blind transaction txout data. (see: CfdGoAddBlindTxOutData)
+ */
+func (h *Handle) AddBlindTxOutData(blindHandle uintptr, index uint32, confidentialKey string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddBlindTxOutData(handle, blindHandle, index, confidentialKey)
+	})
+}
+
+/**
+ * Generate blind transaction. (see: CfdGoFinalizeBlindTx)
+ */
+func (h *Handle) FinalizeBlindTx(blindHandle uintptr, txHex string) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoFinalizeBlindTx(handle, blindHandle, txHex)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Free blind handle. (see: CfdGoFreeBlindHandle)
+ */
+func (h *Handle) FreeBlindHandle(blindHandle uintptr) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoFreeBlindHandle(handle, blindHandle)
+	})
+}
+
+/**
+ * Add sign data to confidential transaction. (see: CfdGoAddConfidentialTxSign)
+ */
+func (h *Handle) AddConfidentialTxSign(txHex string, txid string, vout uint32, isWitness bool, signDataHex string, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddConfidentialTxSign(handle, txHex, txid, vout, isWitness, signDataHex, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Convert to der encode, and add sign data to confidential transaction. (see: CfdGoAddConfidentialTxDerSign)
+ */
+func (h *Handle) AddConfidentialTxDerSign(txHex string, txid string, vout uint32, isWitness bool, signDataHex string, sighashType SigHashType, sighashAnyoneCanPay bool, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddConfidentialTxDerSign(handle, txHex, txid, vout, isWitness, signDataHex, sighashType, sighashAnyoneCanPay, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Add multisig sign data to confidential transaction. (see: CfdGoFinalizeElementsMultisigSign)
+ */
+func (h *Handle) FinalizeElementsMultisigSign(multiSignHandle uintptr, txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoFinalizeElementsMultisigSign(handle, multiSignHandle, txHex, txid, vout, hashType, witnessScript, redeemScript, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Create sighash from confidential transaction. (see: CfdGoCreateConfidentialSighash)
+ */
+func (h *Handle) CreateConfidentialSighash(txHex string, txid string, vout uint32, hashType HashType, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string, sighashType SigHashType, sighashAnyoneCanPay bool) (sighash string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		sighash, err = CfdGoCreateConfidentialSighash(handle, txHex, txid, vout, hashType, pubkey, redeemScript, satoshiAmount, valueCommitment, sighashType, sighashAnyoneCanPay)
+		return err
+	})
+	return sighash, err
+}
+
+/**
+ * Unblind txout on confidential transaction. (see: CfdGoUnblindTxOut)
+ */
+func (h *Handle) UnblindTxOut(txHex string, index uint32, blindingKey string) (asset string, satoshiAmount int64, assetBlindFactor string, valueBlindFactor string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		asset, satoshiAmount, assetBlindFactor, valueBlindFactor, err = CfdGoUnblindTxOut(handle, txHex, index, blindingKey)
+		return err
+	})
+	return asset, satoshiAmount, assetBlindFactor, valueBlindFactor, err
+}
+
+/**
+ * Unblind txin issuance on confidential transaction. (see: CfdGoUnblindIssuance)
+ */
+func (h *Handle) UnblindIssuance(txHex string, index uint32, assetBlindingKey string, tokenBlindingKey string) (asset string, assetAmount int64, assetBlindFactor string, assetValueBlindFactor string, token string, tokenAmount int64, tokenBlindFactor string, tokenValueBlindFactor string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		asset, assetAmount, assetBlindFactor, assetValueBlindFactor, token, tokenAmount, tokenBlindFactor, tokenValueBlindFactor, err = CfdGoUnblindIssuance(handle, txHex, index, assetBlindingKey, tokenBlindingKey)
+		return err
+	})
+	return asset, assetAmount, assetBlindFactor, assetValueBlindFactor, token, tokenAmount, tokenBlindFactor, tokenValueBlindFactor, err
+}
+
+/**
+ * Generate multisig sign handle. (see: CfdGoInitializeMultisigSign)
+ * detail: multisigSignHandle must be released by FreeMultisigSignHandle on this Handle.
+ */
+func (h *Handle) InitializeMultisigSign() (multisigSignHandle uintptr, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		multisigSignHandle, err = CfdGoInitializeMultisigSign(handle)
+		return err
+	})
+	return multisigSignHandle, err
+}
+
+/**
+ * Add multisig sign data. (see: CfdGoAddMultisigSignData)
+ */
+func (h *Handle) AddMultisigSignData(multisigSignHandle uintptr, signature string, relatedPubkey string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddMultisigSignData(handle, multisigSignHandle, signature, relatedPubkey)
+	})
+}
+
+/**
+ * Convert to der encode, and add multisig sign data. (see: CfdGoAddMultisigSignDataToDer)
+ */
+func (h *Handle) AddMultisigSignDataToDer(multisigSignHandle uintptr, signature string, sighashType SigHashType, sighashAnyoneCanPay bool, relatedPubkey string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddMultisigSignDataToDer(handle, multisigSignHandle, signature, sighashType, sighashAnyoneCanPay, relatedPubkey)
+	})
+}
+
+/**
+ * Free multisig sign handle. (see: CfdGoFreeMultisigSignHandle)
+ */
+func (h *Handle) FreeMultisigSignHandle(multisigSignHandle uintptr) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoFreeMultisigSignHandle(handle, multisigSignHandle)
+	})
+}
+
+/**
+ * Create confidential address. (see: CfdGoCreateConfidentialAddress)
+ */
+func (h *Handle) CreateConfidentialAddress(address string, confidentialKey string) (confidentialAddress string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		confidentialAddress, err = CfdGoCreateConfidentialAddress(handle, address, confidentialKey)
+		return err
+	})
+	return confidentialAddress, err
+}
+
+/**
+ * Get address and confidentialKey from confidentialAddress. (see: CfdGoParseConfidentialAddress)
+ */
+func (h *Handle) ParseConfidentialAddress(confidentialAddress string) (address string, confidentialKey string, networkType NetworkType, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		address, confidentialKey, networkType, err = CfdGoParseConfidentialAddress(handle, confidentialAddress)
+		return err
+	})
+	return address, confidentialKey, networkType, err
+}
+
+/**
+ * Calculate ec-signature from privkey. (see: CfdGoCalculateEcSignature)
+ */
+func (h *Handle) CalculateEcSignature(sighash string, privkeyHex string, privkeyWif string, wifNetworkType NetworkType, hasGrindR bool) (signature string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		signature, err = CfdGoCalculateEcSignature(handle, sighash, privkeyHex, privkeyWif, wifNetworkType, hasGrindR)
+		return err
+	})
+	return signature, err
+}
+
+/**
+ * Create key pair. (see: CfdGoCreateKeyPair)
+ */
+func (h *Handle) CreateKeyPair(isCompress bool, networkType NetworkType) (pubkey string, privkeyHex string, privkeyWif string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		pubkey, privkeyHex, privkeyWif, err = CfdGoCreateKeyPair(handle, isCompress, networkType)
+		return err
+	})
+	return pubkey, privkeyHex, privkeyWif, err
+}
+
+/**
+ * Get privkey from WIF. (see: CfdGoGetPrivkeyFromWif)
+ */
+func (h *Handle) GetPrivkeyFromWif(privkeyWif string, networkType NetworkType) (privkeyHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		privkeyHex, err = CfdGoGetPrivkeyFromWif(handle, privkeyWif, networkType)
+		return err
+	})
+	return privkeyHex, err
+}
+
+/**
+ * Parse privkey WIF. (see: CfdGoParsePrivkeyWif)
+ */
+func (h *Handle) ParsePrivkeyWif(privkeyWif string) (privkeyHex string, networkType NetworkType, isCompress bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		privkeyHex, networkType, isCompress, err = CfdGoParsePrivkeyWif(handle, privkeyWif)
+		return err
+	})
+	return privkeyHex, networkType, isCompress, err
+}
+
+/**
+ * Get pubkey from privkey. (see: CfdGoGetPubkeyFromPrivkey)
+ */
+func (h *Handle) GetPubkeyFromPrivkey(privkeyHex string, privkeyWif string, isCompress bool) (pubkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		pubkey, err = CfdGoGetPubkeyFromPrivkey(handle, privkeyHex, privkeyWif, isCompress)
+		return err
+	})
+	return pubkey, err
+}
+
+/**
+ * Tweak add pubkey. (see: CfdGoPubkeyTweakAdd)
+ */
+func (h *Handle) PubkeyTweakAdd(pubkey string, tweak string) (tweakedPubkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		tweakedPubkey, err = CfdGoPubkeyTweakAdd(handle, pubkey, tweak)
+		return err
+	})
+	return tweakedPubkey, err
+}
+
+/**
+ * Compress pubkey. (see: CfdGoCompressPubkey)
+ */
+func (h *Handle) CompressPubkey(pubkey string) (compressedPubkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		compressedPubkey, err = CfdGoCompressPubkey(handle, pubkey)
+		return err
+	})
+	return compressedPubkey, err
+}
+
+/**
+ * Create extkey from seed. (see: CfdGoCreateExtkeyFromSeed)
+ */
+func (h *Handle) CreateExtkeyFromSeed(seed string, networkType NetworkType, keyType ExtKeyType) (extkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		extkey, err = CfdGoCreateExtkeyFromSeed(handle, seed, networkType, keyType)
+		return err
+	})
+	return extkey, err
+}
+
+/**
+ * Create extkey from parent path. (see: CfdGoCreateExtkeyFromParentPath)
+ */
+func (h *Handle) CreateExtkeyFromParentPath(extkey string, path string, networkType NetworkType, keyType ExtKeyType) (childExtkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		childExtkey, err = CfdGoCreateExtkeyFromParentPath(handle, extkey, path, networkType, keyType)
+		return err
+	})
+	return childExtkey, err
+}
+
+/**
+ * Create extpubkey from extprivkey. (see: CfdGoCreateExtPubkey)
+ */
+func (h *Handle) CreateExtPubkey(extkey string, networkType NetworkType) (extPubkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		extPubkey, err = CfdGoCreateExtPubkey(handle, extkey, networkType)
+		return err
+	})
+	return extPubkey, err
+}
+
+/**
+ * Get privkey from extprivkey. (see: CfdGoGetPrivkeyFromExtkey)
+ */
+func (h *Handle) GetPrivkeyFromExtkey(extkey string, networkType NetworkType) (privkeyHex string, privkeyWif string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		privkeyHex, privkeyWif, err = CfdGoGetPrivkeyFromExtkey(handle, extkey, networkType)
+		return err
+	})
+	return privkeyHex, privkeyWif, err
+}
+
+/**
+ * Get pubkey from extkey. (see: CfdGoGetPubkeyFromExtkey)
+ */
+func (h *Handle) GetPubkeyFromExtkey(extkey string, networkType NetworkType) (pubkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		pubkey, err = CfdGoGetPubkeyFromExtkey(handle, extkey, networkType)
+		return err
+	})
+	return pubkey, err
+}
+
+/**
+ * Parse script items from script. (see: CfdGoParseScript)
+ */
+func (h *Handle) ParseScript(script string) (scriptItems []string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		scriptItems, err = CfdGoParseScript(handle, script)
+		return err
+	})
+	return scriptItems, err
+}
+
+/**
+ * Initialize transaction for creating. (see: CfdGoInitializeTransaction)
+ * detail: createHandle must be released by FreeTransactionHandle on this Handle.
+ */
+func (h *Handle) InitializeTransaction(networkType NetworkType, version uint32, locktime uint32, txHex string) (createHandle uintptr, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		createHandle, err = CfdGoInitializeTransaction(handle, networkType, version, locktime, txHex)
+		return err
+	})
+	return createHandle, err
+}
+
+/**
+ * Add txin to transaction. (see: CfdGoAddTransactionInput)
+ */
+func (h *Handle) AddTransactionInput(createHandle uintptr, txid string, vout uint32, sequence uint32) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddTransactionInput(handle, createHandle, txid, vout, sequence)
+	})
+}
+
+/**
+ * Add txout to transaction. (see: CfdGoAddTransactionOutput)
+ */
+func (h *Handle) AddTransactionOutput(createHandle uintptr, satoshiAmount int64, address string, directLockingScript string, asset string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddTransactionOutput(handle, createHandle, satoshiAmount, address, directLockingScript, asset)
+	})
+}
+
+/**
+ * Add pegin txin to transaction. (see: CfdGoAddTxPeginInput)
+ */
+func (h *Handle) AddTxPeginInput(createHandle uintptr, txid string, vout uint32, amount int64, asset string, mainchainGenesisBlockHash string, claimScript string, mainchainTxHex string, txoutProof string) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoAddTxPeginInput(handle, createHandle, txid, vout, amount, asset, mainchainGenesisBlockHash, claimScript, mainchainTxHex, txoutProof)
+	})
+}
+
+/**
+ * Add pegout txout to transaction. (see: CfdGoAddTxPegoutOutput)
+ */
+func (h *Handle) AddTxPegoutOutput(createHandle uintptr, asset string, amount int64, mainchainNetworkType NetworkType, elementsNetworkType NetworkType, mainchainGenesisBlockHash string, onlinePubkey string, masterOnlineKey string, mainchainOutputDescriptor string, bip32Counter uint32, whitelist string) (mainchainAddress string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		mainchainAddress, err = CfdGoAddTxPegoutOutput(handle, createHandle, asset, amount, mainchainNetworkType, elementsNetworkType, mainchainGenesisBlockHash, onlinePubkey, masterOnlineKey, mainchainOutputDescriptor, bip32Counter, whitelist)
+		return err
+	})
+	return mainchainAddress, err
+}
+
+/**
+ * Finalize transaction. (see: CfdGoFinalizeTransaction)
+ */
+func (h *Handle) FinalizeTransaction(createHandle uintptr) (txHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txHex, err = CfdGoFinalizeTransaction(handle, createHandle)
+		return err
+	})
+	return txHex, err
+}
+
+/**
+ * Free transaction create handle. (see: CfdGoFreeTransactionHandle)
+ */
+func (h *Handle) FreeTransactionHandle(createHandle uintptr) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoFreeTransactionHandle(handle, createHandle)
+	})
+}
+
+/**
+ * Get initialized transaction. (see: CfdGoInitializeTx)
+ */
+func (h *Handle) InitializeTx(version uint32, locktime uint32) (txHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		txHex, err = CfdGoInitializeTx(handle, version, locktime)
+		return err
+	})
+	return txHex, err
+}
+
+/**
+ * Add txin to transaction. (see: CfdGoAddTxIn)
+ */
+func (h *Handle) AddTxIn(txHex string, txid string, vout uint32, sequence uint32) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddTxIn(handle, txHex, txid, vout, sequence)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Add txout to transaction. (see: CfdGoAddTxOut)
+ */
+func (h *Handle) AddTxOut(txHex string, satoshiAmount int64, address string, directLockingScript string) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddTxOut(handle, txHex, satoshiAmount, address, directLockingScript)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Update txout of transaction. (see: CfdGoUpdateTxOut)
+ */
+func (h *Handle) UpdateTxOut(txHex string, index uint32, satoshiAmount int64, address string, directLockingScript string) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoUpdateTxOut(handle, txHex, index, satoshiAmount, address, directLockingScript)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Create sighash from transaction. (see: CfdGoCreateSighash)
+ */
+func (h *Handle) CreateSighash(txHex string, txid string, vout uint32, hashType HashType, pubkey string, redeemScript string, satoshiAmount int64, sighashType SigHashType, sighashAnyoneCanPay bool) (sighash string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		sighash, err = CfdGoCreateSighash(handle, txHex, txid, vout, hashType, pubkey, redeemScript, satoshiAmount, sighashType, sighashAnyoneCanPay)
+		return err
+	})
+	return sighash, err
+}
+
+/**
+ * Add sign data to transaction. (see: CfdGoAddTxSign)
+ */
+func (h *Handle) AddTxSign(txHex string, txid string, vout uint32, isWitness bool, signDataHex string, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddTxSign(handle, txHex, txid, vout, isWitness, signDataHex, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Convert to der encode, and add sign data to transaction. (see: CfdGoAddTxDerSign)
+ */
+func (h *Handle) AddTxDerSign(txHex string, txid string, vout uint32, isWitness bool, signDataHex string, sighashType SigHashType, sighashAnyoneCanPay bool, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoAddTxDerSign(handle, txHex, txid, vout, isWitness, signDataHex, sighashType, sighashAnyoneCanPay, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Add multisig sign data to transaction by multisig sign handle. (see: CfdGoFinalizeBitcoinMultisigSign)
+ */
+func (h *Handle) FinalizeBitcoinMultisigSign(multiSignHandle uintptr, txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoFinalizeBitcoinMultisigSign(handle, multiSignHandle, txHex, txid, vout, hashType, witnessScript, redeemScript, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Add multisig sign data to transaction. (see: CfdGoFinalizeMultisigSign)
+ */
+func (h *Handle) FinalizeMultisigSign(txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, signList []CfdMultisigSignData, clearStack bool) (outputTxHex string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		outputTxHex, err = CfdGoFinalizeMultisigSign(handle, txHex, txid, vout, hashType, witnessScript, redeemScript, signList, clearStack)
+		return err
+	})
+	return outputTxHex, err
+}
+
+/**
+ * Decode confidential transaction. (see: CfdGoDecodeConfidentialTx)
+ */
+func (h *Handle) DecodeConfidentialTx(txHex string, networkType NetworkType) (data CfdDecodedConfidentialTx, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoDecodeConfidentialTx(handle, txHex, networkType)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Get schnorr pubkey from privkey. (see: CfdGoGetSchnorrPubkeyFromPrivkey)
+ */
+func (h *Handle) GetSchnorrPubkeyFromPrivkey(privkey string) (pubkey string, parity bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		pubkey, parity, err = CfdGoGetSchnorrPubkeyFromPrivkey(handle, privkey)
+		return err
+	})
+	return pubkey, parity, err
+}
+
+/**
+ * Get schnorr pubkey from pubkey. (see: CfdGoGetSchnorrPubkeyFromPubkey)
+ */
+func (h *Handle) GetSchnorrPubkeyFromPubkey(pubkey string) (schnorrPubkey string, parity bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		schnorrPubkey, parity, err = CfdGoGetSchnorrPubkeyFromPubkey(handle, pubkey)
+		return err
+	})
+	return schnorrPubkey, parity, err
+}
+
+/**
+ * Sign schnorr signature. (see: CfdGoSignSchnorr)
+ */
+func (h *Handle) SignSchnorr(msg string, privkey string, auxRand string) (signature string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		signature, err = CfdGoSignSchnorr(handle, msg, privkey, auxRand)
+		return err
+	})
+	return signature, err
+}
+
+/**
+ * Verify schnorr signature. (see: CfdGoVerifySchnorr)
+ */
+func (h *Handle) VerifySchnorr(signature string, msg string, pubkey string) (isVerify bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		isVerify, err = CfdGoVerifySchnorr(handle, signature, msg, pubkey)
+		return err
+	})
+	return isVerify, err
+}
+
+/**
+ * Tweak add schnorr pubkey. (see: CfdGoSchnorrPubkeyTweakAdd)
+ */
+func (h *Handle) SchnorrPubkeyTweakAdd(pubkey string, tweak string) (tweakedPubkey string, tweakParity bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		tweakedPubkey, tweakParity, err = CfdGoSchnorrPubkeyTweakAdd(handle, pubkey, tweak)
+		return err
+	})
+	return tweakedPubkey, tweakParity, err
+}
+
+/**
+ * Check tweaked schnorr pubkey. (see: CfdGoCheckTweakAddFromSchnorrPubkey)
+ */
+func (h *Handle) CheckTweakAddFromSchnorrPubkey(tweakedPubkey string, tweakParity bool, basePubkey string, tweak string) (isValid bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		isValid, err = CfdGoCheckTweakAddFromSchnorrPubkey(handle, tweakedPubkey, tweakParity, basePubkey, tweak)
+		return err
+	})
+	return isValid, err
+}
+
+/**
+ * Tweak add schnorr privkey. (see: CfdGoSchnorrPrivkeyTweakAdd)
+ */
+func (h *Handle) SchnorrPrivkeyTweakAdd(privkey string, tweak string) (tweakedPrivkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		tweakedPrivkey, err = CfdGoSchnorrPrivkeyTweakAdd(handle, privkey, tweak)
+		return err
+	})
+	return tweakedPrivkey, err
+}
+
+/**
+ * Verify ecdsa signature. (see: CfdGoVerifyEcSignature)
+ */
+func (h *Handle) VerifyEcSignature(sighash string, pubkey string, signature string) (isVerify bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		isVerify, err = CfdGoVerifyEcSignature(handle, sighash, pubkey, signature)
+		return err
+	})
+	return isVerify, err
+}
+
+/**
+ * Encode compact signature to der signature. (see: CfdGoEncodeSignatureByDer)
+ */
+func (h *Handle) EncodeSignatureByDer(signature string, sighashType SigHashType, sighashAnyoneCanPay bool) (derSignature string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		derSignature, err = CfdGoEncodeSignatureByDer(handle, signature, sighashType, sighashAnyoneCanPay)
+		return err
+	})
+	return derSignature, err
+}
+
+/**
+ * Decode der signature to compact signature. (see: CfdGoDecodeSignatureFromDer)
+ */
+func (h *Handle) DecodeSignatureFromDer(derSignature string) (signature string, sighashType SigHashType, sighashAnyoneCanPay bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		signature, sighashType, sighashAnyoneCanPay, err = CfdGoDecodeSignatureFromDer(handle, derSignature)
+		return err
+	})
+	return signature, sighashType, sighashAnyoneCanPay, err
+}
+
+/**
+ * Normalize compact signature to low-S form. (see: CfdGoNormalizeSignature)
+ */
+func (h *Handle) NormalizeSignature(signature string) (normalizedSignature string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		normalizedSignature, err = CfdGoNormalizeSignature(handle, signature)
+		return err
+	})
+	return normalizedSignature, err
+}
+
+/**
+ * Verify signed confidential transaction input. (see: CfdGoVerifyConfidentialTxSign)
+ */
+func (h *Handle) VerifyConfidentialTxSign(txHex string, txid string, vout uint32, lockingScript string, satoshiAmount int64, valueCommitment string) (isSuccess bool, failReason string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		isSuccess, failReason, err = CfdGoVerifyConfidentialTxSign(handle, txHex, txid, vout, lockingScript, satoshiAmount, valueCommitment)
+		return err
+	})
+	return isSuccess, failReason, err
+}
+
+/**
+ * Verify signature of confidential transaction input. (see: CfdGoVerifyConfidentialTxSignature)
+ */
+func (h *Handle) VerifyConfidentialTxSignature(txHex string, txid string, vout uint32, hashType HashType, signature string, pubkey string, redeemScript string, satoshiAmount int64, valueCommitment string) (isVerify bool, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		isVerify, err = CfdGoVerifyConfidentialTxSignature(handle, txHex, txid, vout, hashType, signature, pubkey, redeemScript, satoshiAmount, valueCommitment)
+		return err
+	})
+	return isVerify, err
+}
+
+/**
+ * Get mnemonic word list. (see: CfdGoGetMnemonicWordList)
+ */
+func (h *Handle) GetMnemonicWordList(language string) (wordList []string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		wordList, err = CfdGoGetMnemonicWordList(handle, language)
+		return err
+	})
+	return wordList, err
+}
+
+/**
+ * Convert entropy to mnemonic. (see: CfdGoConvertEntropyToMnemonic)
+ */
+func (h *Handle) ConvertEntropyToMnemonic(entropy string, language string) (mnemonic []string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		mnemonic, err = CfdGoConvertEntropyToMnemonic(handle, entropy, language)
+		return err
+	})
+	return mnemonic, err
+}
+
+/**
+ * Convert mnemonic to entropy. (see: CfdGoConvertMnemonicToEntropy)
+ */
+func (h *Handle) ConvertMnemonicToEntropy(mnemonic []string, language string) (entropy string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		entropy, err = CfdGoConvertMnemonicToEntropy(handle, mnemonic, language)
+		return err
+	})
+	return entropy, err
+}
+
+/**
+ * Convert mnemonic to seed. (see: CfdGoConvertMnemonicToSeed)
+ */
+func (h *Handle) ConvertMnemonicToSeed(mnemonic []string, passphrase string, strictCheck bool, language string) (seed string, entropy string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		seed, entropy, err = CfdGoConvertMnemonicToSeed(handle, mnemonic, passphrase, strictCheck, language)
+		return err
+	})
+	return seed, entropy, err
+}
+
+/**
+ * Get extkey information. (see: CfdGoGetExtkeyInformation)
+ */
+func (h *Handle) GetExtkeyInformation(extkey string) (data CfdExtkeyData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoGetExtkeyInformation(handle, extkey)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Create extkey from the key information. (see: CfdGoCreateExtkey)
+ */
+func (h *Handle) CreateExtkey(networkType NetworkType, keyType ExtKeyType, fingerprint string, key string, chainCode string, depth byte, childNumber uint32) (extkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		extkey, err = CfdGoCreateExtkey(handle, networkType, keyType, fingerprint, key, chainCode, depth, childNumber)
+		return err
+	})
+	return extkey, err
+}
+
+/**
+ * Create extkey from the parent key and the key information. (see: CfdGoCreateExtkeyFromParent)
+ */
+func (h *Handle) CreateExtkeyFromParent(networkType NetworkType, keyType ExtKeyType, parentKey string, key string, chainCode string, depth byte, childNumber uint32) (extkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		extkey, err = CfdGoCreateExtkeyFromParent(handle, networkType, keyType, parentKey, key, chainCode, depth, childNumber)
+		return err
+	})
+	return extkey, err
+}
+
+/**
+ * Convert extkey version. (see: CfdGoConvertExtkeyVersion)
+ */
+func (h *Handle) ConvertExtkeyVersion(extkey string, hashType HashType) (convertedExtkey string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		convertedExtkey, err = CfdGoConvertExtkeyVersion(handle, extkey, hashType)
+		return err
+	})
+	return convertedExtkey, err
+}
+
+/**
+ * Get descriptor checksum. (see: CfdGoGetDescriptorChecksum)
+ */
+func (h *Handle) GetDescriptorChecksum(descriptor string, networkType NetworkType) (checksum string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		checksum, err = CfdGoGetDescriptorChecksum(handle, descriptor, networkType)
+		return err
+	})
+	return checksum, err
+}
+
+/**
+ * Append checksum to descriptor. (see: CfdGoAppendDescriptorChecksum)
+ */
+func (h *Handle) AppendDescriptorChecksum(descriptor string, networkType NetworkType) (descriptorWithChecksum string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		descriptorWithChecksum, err = CfdGoAppendDescriptorChecksum(handle, descriptor, networkType)
+		return err
+	})
+	return descriptorWithChecksum, err
+}
+
+/**
+ * Verify descriptor checksum. (see: CfdGoVerifyDescriptorChecksum)
+ */
+func (h *Handle) VerifyDescriptorChecksum(descriptor string, networkType NetworkType) (err error) {
+	return h.Do(func(handle uintptr) error {
+		return CfdGoVerifyDescriptorChecksum(handle, descriptor, networkType)
+	})
+}
+
+/**
+ * Create Output Descriptor. (see: CfdGoCreateDescriptor)
+ */
+func (h *Handle) CreateDescriptor(scriptTypes []DescriptorScriptType, keyDataList []CfdDescriptorKeyData, keyOriginList []CfdDescriptorKeyOrigin, requireNum uint32, networkType NetworkType) (descriptor string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		descriptor, err = CfdGoCreateDescriptor(handle, scriptTypes, keyDataList, keyOriginList, requireNum, networkType)
+		return err
+	})
+	return descriptor, err
+}
+
+/**
+ * Get address information. (see: CfdGoGetAddressInfo)
+ */
+func (h *Handle) GetAddressInfo(address string) (data CfdAddressData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoGetAddressInfo(handle, address)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Validate address of the network. (see: CfdGoValidateAddress)
+ */
+func (h *Handle) ValidateAddress(address string, networkType NetworkType) (data CfdAddressData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoValidateAddress(handle, address, networkType)
+		return err
+	})
+	return data, err
+}
+
+/**
+ * Get address from locking script. (see: CfdGoGetAddressFromLockingScript)
+ */
+func (h *Handle) GetAddressFromLockingScript(lockingScript string, networkType NetworkType) (address string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		address, err = CfdGoGetAddressFromLockingScript(handle, lockingScript, networkType)
+		return err
+	})
+	return address, err
+}
+
+/**
+ * Get pegin address. (see: CfdGoGetPeginAddress)
+ */
+func (h *Handle) GetPeginAddress(mainchainNetworkType NetworkType, fedpegScript string, hashType HashType, pubkey string, redeemScript string) (peginAddress string, claimScript string, tweakedFedpegScript string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		peginAddress, claimScript, tweakedFedpegScript, err = CfdGoGetPeginAddress(handle, mainchainNetworkType, fedpegScript, hashType, pubkey, redeemScript)
+		return err
+	})
+	return peginAddress, claimScript, tweakedFedpegScript, err
+}
+
+/**
+ * Get pegout address. (see: CfdGoGetPegoutAddress)
+ */
+func (h *Handle) GetPegoutAddress(mainchainNetworkType NetworkType, elementsNetworkType NetworkType, descriptor string, bip32Counter uint32, addressType AddressType) (mainchainAddress string, baseDescriptor string, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		mainchainAddress, baseDescriptor, err = CfdGoGetPegoutAddress(handle, mainchainNetworkType, elementsNetworkType, descriptor, bip32Counter, addressType)
+		return err
+	})
+	return mainchainAddress, baseDescriptor, err
+}
+
+/**
+ * Decode pegout locking script. (see: CfdGoDecodePegoutLockingScript)
+ */
+func (h *Handle) DecodePegoutLockingScript(lockingScript string, mainchainNetworkType NetworkType) (data CfdPegoutData, err error) {
+	err = h.Do(func(handle uintptr) (err error) {
+		data, err = CfdGoDecodePegoutLockingScript(handle, lockingScript, mainchainNetworkType)
+		return err
+	})
+	return data, err
+}
@@ -0,0 +1,52 @@
+package cfdgo
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHandle(t *testing.T) {
+	handle, err := NewHandle()
+	assert.NoError(t, err)
+
+	hashType := KCfdP2pkh
+	networkType := KCfdNetworkLiquidv1
+	pubkey := "0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798"
+	address, lockingScript, segwitLockingScript, err := handle.CreateAddress(hashType, pubkey, "", networkType)
+	assert.NoError(t, err)
+	assert.Equal(t, "Q7wegLt2qMGhm28vch6VTzvpzs8KXvs4X7", address)
+	assert.Equal(t, "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac", lockingScript)
+	assert.Equal(t, "", segwitLockingScript)
+
+	_, _, _, err = handle.CreateAddress(200, "", "", 200)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+	assert.Equal(t, "CFD Error: message=[Illegal network type.], code=[1], operation=[CfdGoCreateAddress]", err.Error())
+
+	err = handle.Close()
+	assert.NoError(t, err)
+	// closing twice is allowed.
+	err = handle.Close()
+	assert.NoError(t, err)
+
+	_, _, _, err = handle.CreateAddress(hashType, pubkey, "", networkType)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrIllegalState))
+	fmt.Print("TestHandle test done.\n")
+}
+
+func TestHandleBlindTx(t *testing.T) {
+	handle, err := NewHandle()
+	assert.NoError(t, err)
+	defer handle.Close()
+
+	blindHandle, err := handle.InitializeBlindTx()
+	assert.NoError(t, err)
+	err = handle.AddBlindTxOutData(blindHandle, uint32(0), "02200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d")
+	assert.NoError(t, err)
+	err = handle.FreeBlindHandle(blindHandle)
+	assert.NoError(t, err)
+	fmt.Print("TestHandleBlindTx test done.\n")
+}
@@ -0,0 +1,16 @@
+package cfdgo
+
+import (
+	"crypto/sha256"
+)
+
+/**
+ * Calculate double sha256.
+ * param: data         target data
+ * return: hash        hash256 (32 bytes)
+ */
+func hash256(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
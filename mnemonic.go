@@ -0,0 +1,38 @@
+package cfdgo
+
+import (
+	"strings"
+)
+
+// mnemonic language
+const (
+	// MnemonicLanguageEn english
+	MnemonicLanguageEn = "en"
+	// MnemonicLanguageJp japanese
+	MnemonicLanguageJp = "jp"
+)
+
+// mnemonicIdeographicSpace japanese mnemonic separator.
+const mnemonicIdeographicSpace = "　"
+
+/**
+ * Split mnemonic sentence to words.
+ * param: mnemonicSentence   mnemonic sentence (separated by space or ideographic space)
+ * return: mnemonic          mnemonic words
+ */
+func SplitMnemonic(mnemonicSentence string) (mnemonic []string) {
+	return strings.Fields(strings.Replace(mnemonicSentence, mnemonicIdeographicSpace, " ", -1))
+}
+
+/**
+ * Join mnemonic words to sentence.
+ * param: mnemonic           mnemonic words
+ * param: language           language (japanese uses ideographic space)
+ * return: mnemonicSentence  mnemonic sentence
+ */
+func JoinMnemonic(mnemonic []string, language string) (mnemonicSentence string) {
+	if language == MnemonicLanguageJp {
+		return strings.Join(mnemonic, mnemonicIdeographicSpace)
+	}
+	return strings.Join(mnemonic, " ")
+}
@@ -0,0 +1,133 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdMnemonicEnglish(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	wordList, err := CfdGoGetMnemonicWordList(handle, MnemonicLanguageEn)
+	assert.NoError(t, err)
+	assert.Equal(t, 2048, len(wordList))
+	assert.Equal(t, "abandon", wordList[0])
+	assert.Equal(t, "zoo", wordList[2047])
+
+	testVectors := []struct {
+		entropy    string
+		mnemonic   string
+		passphrase string
+		seed       string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"TREZOR",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"TREZOR",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			"9e885d952ad362caeb4efe34a8e91bd2",
+			"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+			"TREZOR",
+			"274ddc525802f7c828d8ef7ddbcdc5304e87ac3535913611fbbfa986d0c9e5476c91689f9c8a54fd55bd38606aa6a8595ad213d4c9c9f9aca3fb217069a41028",
+		},
+		{
+			"f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f",
+			"void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+			"",
+			"b873212f885ccffbf4692afcb84bc2e55886de2dfa07d90f5c3c239abc31c0a6ce047e30fd8bf6a281e71389aa82d73df74c7bbfb3b06b4639a5cee775cccd3c",
+		},
+	}
+	for _, vector := range testVectors {
+		mnemonic, err := CfdGoConvertEntropyToMnemonic(handle, vector.entropy, MnemonicLanguageEn)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.mnemonic, JoinMnemonic(mnemonic, MnemonicLanguageEn))
+
+		entropy, err := CfdGoConvertMnemonicToEntropy(handle, SplitMnemonic(vector.mnemonic), MnemonicLanguageEn)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.entropy, entropy)
+
+		seed, entropy, err := CfdGoConvertMnemonicToSeed(handle, mnemonic, vector.passphrase, true, MnemonicLanguageEn)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.seed, seed)
+		assert.Equal(t, vector.entropy, entropy)
+	}
+
+	// invalid checksum
+	invalidMnemonic := SplitMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	_, err = CfdGoConvertMnemonicToEntropy(handle, invalidMnemonic, MnemonicLanguageEn)
+	assert.Error(t, err)
+	_, _, err = CfdGoConvertMnemonicToSeed(handle, invalidMnemonic, "TREZOR", true, MnemonicLanguageEn)
+	assert.Error(t, err)
+	seed, entropy, err := CfdGoConvertMnemonicToSeed(handle, invalidMnemonic, "TREZOR", false, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 128, len(seed))
+	assert.Equal(t, "", entropy)
+
+	// invalid word, count, entropy, language
+	_, err = CfdGoConvertMnemonicToEntropy(handle, SplitMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon bitcoin"), MnemonicLanguageEn)
+	assert.Error(t, err)
+	_, err = CfdGoConvertMnemonicToEntropy(handle, SplitMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"), MnemonicLanguageEn)
+	assert.Error(t, err)
+	_, err = CfdGoConvertEntropyToMnemonic(handle, "000000000000000000000000000000", MnemonicLanguageEn)
+	assert.Error(t, err)
+	_, err = CfdGoGetMnemonicWordList(handle, "fr")
+	assert.Error(t, err)
+
+	// passphrase is normalized by NFKD.
+	seed, _, err = CfdGoConvertMnemonicToSeed(handle, SplitMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"), "Ünïcödé", false, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "dc5b5eff223ad9a0e6fe1c69fb67515e5d3a7f64144b5cba7302211281edddded0ea0e9c81167e4fb9676dc2d56115b72b05a1abab922a84c59d9654813ffb7c", seed)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdMnemonicEnglish test done.\n")
+}
+
+func TestCfdMnemonicJapanese(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	wordList, err := CfdGoGetMnemonicWordList(handle, MnemonicLanguageJp)
+	assert.NoError(t, err)
+	assert.Equal(t, 2048, len(wordList))
+	assert.Equal(t, "あいこくしん", wordList[0])
+
+	// composed kana is accepted.
+	mnemonicSentence := "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら"
+	mnemonic := SplitMnemonic(mnemonicSentence)
+	assert.Equal(t, 12, len(mnemonic))
+	entropy, err := CfdGoConvertMnemonicToEntropy(handle, mnemonic, MnemonicLanguageJp)
+	assert.NoError(t, err)
+	assert.Equal(t, "00000000000000000000000000000000", entropy)
+	seed, _, err := CfdGoConvertMnemonicToSeed(handle, mnemonic, "㍍ガバヴァぱばぐゞちぢ十人十色", true, MnemonicLanguageJp)
+	assert.NoError(t, err)
+	assert.Equal(t, "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55", seed)
+
+	// generated words give the same seed.
+	generated, err := CfdGoConvertEntropyToMnemonic(handle, "8080808080808080808080808080808080808080808080808080808080808080", MnemonicLanguageJp)
+	assert.NoError(t, err)
+	assert.Equal(t, 24, len(generated))
+	mnemonic = SplitMnemonic("そとづら　あまど　おおう　あこがれる　いくぶん　けいけん　あたえる　いよく　そとづら　あまど　おおう　あこがれる　いくぶん　けいけん　あたえる　いよく　そとづら　あまど　おおう　あこがれる　いくぶん　けいけん　あたえる　うめる")
+	seed, entropy, err = CfdGoConvertMnemonicToSeed(handle, mnemonic, "ｶﾞﾊﾞ　ＴＲＥＺＯＲ", true, MnemonicLanguageJp)
+	assert.NoError(t, err)
+	assert.Equal(t, "8080808080808080808080808080808080808080808080808080808080808080", entropy)
+	assert.Equal(t, "dfe523a1e493523e855ab7d911db1badfae3e3a9d3161e20c1c1c9328fc63b22064d0c8bb544aab2a66e10558cc7e57e75aa5cf8983bb417750dc2226f1504d1", seed)
+	generatedSeed, _, err := CfdGoConvertMnemonicToSeed(handle, generated, "ｶﾞﾊﾞ　ＴＲＥＺＯＲ", false, "")
+	assert.NoError(t, err)
+	assert.Equal(t, seed, generatedSeed)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdMnemonicJapanese test done.\n")
+}
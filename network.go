@@ -0,0 +1,258 @@
+package cfdgo
+
+import (
+	"errors"
+	"sync"
+)
+
+/**
+ * Custom network parameter struct. (for KCfdNetworkCustomChain)
+ * detail: cfd has no custom bech32 hrp, so the segwit address of the custom
+ *         network is not supported.
+ */
+type CfdNetworkParams struct {
+	// elements network flag
+	IsElements bool
+	// p2pkh address prefix
+	P2pkhPrefix byte
+	// p2sh address prefix
+	P2shPrefix byte
+	// confidential address prefix (elements only)
+	ConfidentialPrefix byte
+	// WIF privkey prefix
+	WifPrefix byte
+	// extended privkey version
+	ExtPrivkeyVersion uint32
+	// extended pubkey version
+	ExtPubkeyVersion uint32
+}
+
+/**
+ * Custom network parameter registry.
+ * detail: the registry has one parameter, and it is process global.
+ *         the network type apis (ex: NetworkType.IsElements) have no handle,
+ *         so the parameter is not scoped to the cfd handle.
+ */
+var customNetworkRegistry struct {
+	mutex  sync.RWMutex
+	params *CfdNetworkParams
+}
+
+/**
+ * Get registered custom network parameter.
+ * return: params      custom network parameter (nil if not registered)
+ */
+func getCustomNetworkParams() (params *CfdNetworkParams) {
+	customNetworkRegistry.mutex.RLock()
+	defer customNetworkRegistry.mutex.RUnlock()
+	if customNetworkRegistry.params == nil {
+		return nil
+	}
+	copied := *customNetworkRegistry.params
+	return &copied
+}
+
+/**
+ * Check the network is the registered custom network.
+ * param: networkType  network type
+ * return: isCustom    custom network flag
+ */
+func isCustomNetwork(networkType NetworkType) bool {
+	return networkType == KCfdNetworkCustomChain && getCustomNetworkParams() != nil
+}
+
+/**
+ * Get network type for the native library.
+ * detail: custom network is replaced by the testnet (or elements regtest).
+ * param: networkType  network type
+ * return: nativeNetworkType  network type for the native library
+ */
+func getNativeNetworkType(networkType NetworkType) (nativeNetworkType NetworkType) {
+	if params := getCustomNetworkParams(); params != nil && networkType == KCfdNetworkCustomChain {
+		if params.IsElements {
+			return KCfdNetworkElementsRegtest
+		}
+		return KCfdNetworkTestnet
+	}
+	return networkType
+}
+
+/**
+ * Swap the base58 address prefix.
+ * detail: the native library has no custom address prefix, so the address of
+ *         the custom network is converted from/to the native network address.
+ *         bech32 address is not supported.
+ * param: address      base58 address (or base58 confidential address)
+ * param: from         address prefix of the address
+ * param: to           address prefix of the converted address
+ * return: converted   converted address
+ * return: err         error
+ */
+func swapAddressPrefix(address string, from *addressPrefix, to *addressPrefix) (converted string, err error) {
+	payload, err := decodeBase58Check(address)
+	if err != nil {
+		return "", errors.New("Custom network supports base58 address only.")
+	}
+	payload = append([]byte{}, payload...)
+	index := 0
+	if len(payload) == 2+33+20 && from.isElements && to.isElements && payload[0] == from.confidential {
+		payload[0] = to.confidential
+		index = 1
+	} else if len(payload) != 1+20 {
+		return "", errors.New("Unknown address format.")
+	}
+	switch payload[index] {
+	case from.p2pkh:
+		payload[index] = to.p2pkh
+	case from.p2sh:
+		payload[index] = to.p2sh
+	default:
+		return "", errors.New("Unknown address prefix.")
+	}
+	return encodeBase58Check(payload), nil
+}
+
+/**
+ * Convert the native network address to the custom network address.
+ * param: nativeAddress  address of the native network (see: getNativeNetworkType)
+ * return: address       custom network address
+ * return: err           error
+ */
+func toCustomNetworkAddress(nativeAddress string) (address string, err error) {
+	customPrefix, err := getAddressPrefix(KCfdNetworkCustomChain)
+	if err != nil {
+		return "", errors.New("Custom network is not registered.")
+	}
+	nativePrefix, _ := getAddressPrefix(getNativeNetworkType(KCfdNetworkCustomChain))
+	return swapAddressPrefix(nativeAddress, nativePrefix, customPrefix)
+}
+
+/**
+ * Convert the custom network address to the native network address.
+ * param: address        custom network address
+ * return: nativeAddress  address of the native network (see: getNativeNetworkType)
+ * return: isCustom      custom network address flag
+ */
+func toNativeNetworkAddress(address string) (nativeAddress string, isCustom bool) {
+	customPrefix, err := getAddressPrefix(KCfdNetworkCustomChain)
+	if err != nil {
+		return "", false
+	}
+	nativePrefix, _ := getAddressPrefix(getNativeNetworkType(KCfdNetworkCustomChain))
+	if nativeAddress, err = swapAddressPrefix(address, customPrefix, nativePrefix); err != nil {
+		return "", false
+	}
+	return nativeAddress, true
+}
+
+/**
+ * Encode privkey WIF of the custom network.
+ * param: privkeyHex   privkey hex
+ * param: isCompress   compressed pubkey flag
+ * return: wif         privkey WIF
+ * return: err         error
+ */
+func encodeCustomNetworkWif(privkeyHex string, isCompress bool) (wif string, err error) {
+	params := getCustomNetworkParams()
+	if params == nil {
+		return "", errors.New("Custom network is not registered.")
+	}
+	privkey, err := decodeFixedSizeHex(privkeyHex, 32, "Invalid privkey.")
+	if err != nil {
+		return "", err
+	}
+	payload := append([]byte{params.WifPrefix}, privkey...)
+	if isCompress {
+		payload = append(payload, 0x01)
+	}
+	return encodeBase58Check(payload), nil
+}
+
+/**
+ * Validate custom network parameter.
+ * return: err         error
+ */
+func (p *CfdNetworkParams) validate() error {
+	if p.P2pkhPrefix == p.P2shPrefix {
+		return errors.New("Invalid address prefix.")
+	}
+	if p.IsElements && (p.ConfidentialPrefix == p.P2pkhPrefix || p.ConfidentialPrefix == p.P2shPrefix) {
+		return errors.New("Invalid confidential address prefix.")
+	}
+	if p.ExtPrivkeyVersion == p.ExtPubkeyVersion {
+		return errors.New("Invalid extkey version.")
+	}
+
+	// reject the parameter that collides with the default networks.
+	for _, prefix := range getAddressPrefixes() {
+		if prefix.networkType == KCfdNetworkCustomChain {
+			continue
+		}
+		builtinPrefixes := []byte{prefix.p2pkh, prefix.p2sh}
+		if prefix.isElements {
+			builtinPrefixes = append(builtinPrefixes, prefix.confidential)
+		}
+		for _, builtinPrefix := range builtinPrefixes {
+			if p.P2pkhPrefix == builtinPrefix || p.P2shPrefix == builtinPrefix ||
+				(p.IsElements && p.ConfidentialPrefix == builtinPrefix) {
+				return errors.New("Address prefix is used by the default network.")
+			}
+		}
+	}
+	for _, version := range getExtkeyVersions() {
+		if version.networkType == KCfdNetworkCustomChain {
+			continue
+		}
+		for _, builtinVersion := range []uint32{version.privkey, version.pubkey} {
+			if p.ExtPrivkeyVersion == builtinVersion || p.ExtPubkeyVersion == builtinVersion {
+				return errors.New("Extkey version is used by the default network.")
+			}
+		}
+	}
+	return nil
+}
+
+/**
+ * Register custom network parameter.
+ * detail: the parameter is used for KCfdNetworkCustomChain.
+ *         the parameter that collides with the default networks is rejected.
+ *         the registry is process global, so the parameter is shared by all
+ *         cfd handles.
+ * param: params       custom network parameter
+ * return: err         error
+ */
+func CfdGoSetCustomNetworkParams(params CfdNetworkParams) (err error) {
+	if err = params.validate(); err != nil {
+		return convertGoError(err, "CfdGoSetCustomNetworkParams")
+	}
+	customNetworkRegistry.mutex.Lock()
+	defer customNetworkRegistry.mutex.Unlock()
+	customNetworkRegistry.params = &params
+	return nil
+}
+
+/**
+ * Get registered custom network parameter.
+ * detail: the registry is process global. (see: CfdGoSetCustomNetworkParams)
+ * return: params      custom network parameter
+ * return: err         error
+ */
+func CfdGoGetCustomNetworkParams() (params CfdNetworkParams, err error) {
+	registered := getCustomNetworkParams()
+	if registered == nil {
+		return params, convertGoError(errors.New("Custom network is not registered."), "CfdGoGetCustomNetworkParams")
+	}
+	return *registered, nil
+}
+
+/**
+ * Clear registered custom network parameter.
+ * detail: the registry is process global. (see: CfdGoSetCustomNetworkParams)
+ * return: err         error
+ */
+func CfdGoClearCustomNetworkParams() (err error) {
+	customNetworkRegistry.mutex.Lock()
+	defer customNetworkRegistry.mutex.Unlock()
+	customNetworkRegistry.params = nil
+	return nil
+}
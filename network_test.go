@@ -0,0 +1,239 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestCustomNetworkParams() CfdNetworkParams {
+	return CfdNetworkParams{
+		IsElements:         true,
+		P2pkhPrefix:        0x1e,
+		P2shPrefix:         0x16,
+		ConfidentialPrefix: 0x17,
+		WifPrefix:          0x9e,
+		ExtPrivkeyVersion:  0x02fac398,
+		ExtPubkeyVersion:   0x02facafd,
+	}
+}
+
+func TestCfdCustomNetworkParams(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+	defer CfdGoClearCustomNetworkParams()
+
+	_, err = CfdGoGetCustomNetworkParams()
+	assert.Error(t, err)
+	assert.False(t, KCfdNetworkCustomChain.IsElements())
+	_, err = CfdGoGetAddressFromLockingScript(handle,
+		"0014751e76e8199196d454941c45d1b3a323f1433bd6", KCfdNetworkCustomChain)
+	assert.Error(t, err)
+
+	params := getTestCustomNetworkParams()
+	err = CfdGoSetCustomNetworkParams(params)
+	assert.NoError(t, err)
+	registered, err := CfdGoGetCustomNetworkParams()
+	assert.NoError(t, err)
+	assert.Equal(t, params, registered)
+	assert.True(t, KCfdNetworkCustomChain.IsElements())
+
+	invalidParams := getTestCustomNetworkParams()
+	invalidParams.P2shPrefix = invalidParams.P2pkhPrefix
+	assert.Error(t, CfdGoSetCustomNetworkParams(invalidParams))
+	invalidParams = getTestCustomNetworkParams()
+	invalidParams.ConfidentialPrefix = invalidParams.P2shPrefix
+	assert.Error(t, CfdGoSetCustomNetworkParams(invalidParams))
+	invalidParams = getTestCustomNetworkParams()
+	invalidParams.ExtPubkeyVersion = invalidParams.ExtPrivkeyVersion
+	assert.Error(t, CfdGoSetCustomNetworkParams(invalidParams))
+	// collision with the default networks
+	invalidParams = getTestCustomNetworkParams()
+	invalidParams.P2pkhPrefix = 0x00
+	err = CfdGoSetCustomNetworkParams(invalidParams)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Address prefix is used by the default network.")
+	invalidParams = getTestCustomNetworkParams()
+	invalidParams.ConfidentialPrefix = 0x0c
+	assert.Error(t, CfdGoSetCustomNetworkParams(invalidParams))
+	invalidParams = getTestCustomNetworkParams()
+	invalidParams.ExtPubkeyVersion = 0x043587cf
+	err = CfdGoSetCustomNetworkParams(invalidParams)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Extkey version is used by the default network.")
+	registered, err = CfdGoGetCustomNetworkParams()
+	assert.NoError(t, err)
+	assert.Equal(t, params, registered)
+
+	assert.NoError(t, CfdGoClearCustomNetworkParams())
+	_, err = CfdGoGetCustomNetworkParams()
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCustomNetworkParams test done.\n")
+}
+
+func TestCfdCustomNetworkAddress(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+	err = CfdGoSetCustomNetworkParams(getTestCustomNetworkParams())
+	assert.NoError(t, err)
+	defer CfdGoClearCustomNetworkParams()
+
+	pubkey := "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	testVectors := []struct {
+		hashType                HashType
+		pubkey                  string
+		redeemScript            string
+		address                 string
+		lockingScript           string
+		p2shSegwitLockingScript string
+	}{
+		{KCfdP2pkh, pubkey, "", "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE",
+			"76a914751e76e8199196d454941c45d1b3a323f1433bd688ac", ""},
+		{KCfdP2sh, "", "51", "ACKRrFZj7owiKKQf81aeQMKN7PANbv7h8f",
+			"a914da1745e9b549bd0bfa1a569971c77eba30cd5a4b87", ""},
+		{KCfdP2shP2wpkh, pubkey, "", "A9faqPqnCRNQcZjkcFTviEQiLrkLPctjsJ",
+			"a914bcfeb728b584253d5f3f70bcb780e9ef218a68f487", "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+	}
+	for _, vector := range testVectors {
+		address, lockingScript, p2shSegwitLockingScript, err := CfdGoCreateAddress(
+			handle, vector.hashType, vector.pubkey, vector.redeemScript, KCfdNetworkCustomChain)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+		assert.Equal(t, vector.lockingScript, lockingScript)
+		assert.Equal(t, vector.p2shSegwitLockingScript, p2shSegwitLockingScript)
+
+		data, err := CfdGoValidateAddress(handle, vector.address, KCfdNetworkCustomChain)
+		assert.NoError(t, err)
+		assert.Equal(t, KCfdNetworkCustomChain, data.NetworkType)
+		assert.Equal(t, vector.lockingScript, data.LockingScript)
+
+		address, err = CfdGoGetAddressFromLockingScript(handle, vector.lockingScript, KCfdNetworkCustomChain)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+	}
+
+	// the native library has no custom bech32 hrp.
+	_, _, _, err = CfdGoCreateAddress(handle, KCfdP2wpkh, pubkey, "", KCfdNetworkCustomChain)
+	assert.Error(t, err)
+	_, err = CfdGoGetAddressFromLockingScript(handle, "0014751e76e8199196d454941c45d1b3a323f1433bd6", KCfdNetworkCustomChain)
+	assert.Error(t, err)
+	_, err = CfdGoValidateAddress(handle, "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE", KCfdNetworkLiquidv1)
+	assert.Error(t, err)
+
+	confidentialKey := "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+	confidentialVectors := []struct {
+		address             string
+		confidentialAddress string
+	}{
+		{"DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE",
+			"vqGb38MLy4EHCu6bW1orNJmi5ib4f3qddekdyGUoJxKJV9yEFz4ZPim3nRgk5r3EbpqQ8GfQL55hd1zu"},
+	}
+	for _, vector := range confidentialVectors {
+		confidentialAddress, err := CfdGoCreateConfidentialAddress(handle, vector.address, confidentialKey)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.confidentialAddress, confidentialAddress)
+
+		address, key, networkType, err := CfdGoParseConfidentialAddress(handle, vector.confidentialAddress)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.address, address)
+		assert.Equal(t, confidentialKey, key)
+		assert.Equal(t, KCfdNetworkCustomChain, networkType)
+
+		data, err := CfdGoGetAddressInfo(handle, vector.confidentialAddress)
+		assert.NoError(t, err)
+		assert.Equal(t, KCfdNetworkCustomChain, data.NetworkType)
+		assert.Equal(t, vector.address, data.UnconfidentialAddress)
+		assert.Equal(t, confidentialKey, data.ConfidentialKey)
+	}
+
+	// the native library has no custom blech32 hrp.
+	_, err = CfdGoCreateConfidentialAddress(handle, "tex1qw508d6qejxtdg4y5r3zarvary0c5xw7kugxq67", confidentialKey)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCustomNetworkAddress test done.\n")
+}
+
+func TestCfdCustomNetworkExtkey(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+	err = CfdGoSetCustomNetworkParams(getTestCustomNetworkParams())
+	assert.NoError(t, err)
+	defer CfdGoClearCustomNetworkParams()
+
+	privkey := "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"
+	chainCode := "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508"
+	extPrivkey := "dgpv51eADS3spNJh9Gjth94XcPwAczvQaDJs9rqx11kvxKs6r3Ek8AgERHhjLs6mzXQFHRzQqGwqdeoDkZmr8jQMBfi43b7sT3sx3cCSk5fGeUR"
+	extPubkey := "dgub8kXBZ7ymNWy2S8Q3jNgVjFUm5ZJ3QLLaSTdAA89ukSv7Q6MSXwE14b7Nv6eDpE9JJXinTKc8LeLVu19uDPrm5uJuhpKNzV2kAgncwo6bNpP"
+
+	extkey, err := CfdGoCreateExtkey(handle, KCfdNetworkCustomChain, KCfdExtPrivkey, "", privkey, chainCode, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, extPrivkey, extkey)
+	extkey, err = CfdGoCreateExtkey(handle, KCfdNetworkCustomChain, KCfdExtPubkey, "",
+		"0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2", chainCode, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, extPubkey, extkey)
+
+	data, err := CfdGoGetExtkeyInformation(handle, extPrivkey)
+	assert.NoError(t, err)
+	assert.Equal(t, "02fac398", data.Version)
+	assert.Equal(t, KCfdNetworkCustomChain, data.Network)
+	assert.Equal(t, KCfdExtPrivkey, data.KeyType)
+	assert.Equal(t, privkey, data.Key)
+
+	_, err = CfdGoConvertExtkeyVersion(handle, extPrivkey, KCfdP2wpkh)
+	assert.Error(t, err)
+
+	assert.NoError(t, CfdGoClearCustomNetworkParams())
+	_, err = CfdGoGetExtkeyInformation(handle, extPrivkey)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCustomNetworkExtkey test done.\n")
+}
+
+func TestCfdCustomNetworkExtkeyDerive(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+	err = CfdGoSetCustomNetworkParams(getTestCustomNetworkParams())
+	assert.NoError(t, err)
+	defer CfdGoClearCustomNetworkParams()
+
+	extPrivkey := "dgpv51eADS3spNJh9Gjth94XcPwAczvQaDJs9rqx11kvxKs6r3Ek8AgERHhjLs6mzXQFHRzQqGwqdeoDkZmr8jQMBfi43b7sT3sx3cCSk5fGeUR"
+	extPubkey := "dgub8kXBZ7ymNWy2S8Q3jNgVjFUm5ZJ3QLLaSTdAA89ukSv7Q6MSXwE14b7Nv6eDpE9JJXinTKc8LeLVu19uDPrm5uJuhpKNzV2kAgncwo6bNpP"
+
+	extkey, err := CfdGoCreateExtkeyFromSeed(handle, "000102030405060708090a0b0c0d0e0f", KCfdNetworkCustomChain, KCfdExtPrivkey)
+	assert.NoError(t, err)
+	assert.Equal(t, extPrivkey, extkey)
+
+	pubkey, err := CfdGoCreateExtPubkey(handle, extPrivkey, KCfdNetworkCustomChain)
+	assert.NoError(t, err)
+	assert.Equal(t, extPubkey, pubkey)
+
+	privkeyHex, privkeyWif, err := CfdGoGetPrivkeyFromExtkey(handle, extPrivkey, KCfdNetworkCustomChain)
+	assert.NoError(t, err)
+	assert.Equal(t, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", privkeyHex)
+	assert.Equal(t, "QWRT9AqbcMn65Uaa2F5cfcFAjWtcwsr8vfgvKDZU25HnS9gkJG7W", privkeyWif)
+
+	pubkey, err = CfdGoGetPubkeyFromExtkey(handle, extPubkey, KCfdNetworkCustomChain)
+	assert.NoError(t, err)
+	assert.Equal(t, "0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2", pubkey)
+
+	childExtkey, err := CfdGoCreateExtkeyFromParentPath(handle, extPrivkey, "0'/1", KCfdNetworkCustomChain, KCfdExtPubkey)
+	assert.NoError(t, err)
+	data, err := CfdGoGetExtkeyInformation(handle, childExtkey)
+	assert.NoError(t, err)
+	assert.Equal(t, "02facafd", data.Version)
+	assert.Equal(t, uint32(2), data.Depth)
+	assert.Equal(t, "03501e454bf00751f24b1b489aa925215d66af2234e3891c3b21a52bedb3cd711c", data.Key)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCustomNetworkExtkeyDerive test done.\n")
+}
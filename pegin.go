@@ -0,0 +1,21 @@
+package cfdgo
+
+import (
+	"errors"
+)
+
+/**
+ * Get address prefix of the mainchain network.
+ * param: mainchainNetworkType  mainchain network type (mainnet, testnet, regtest)
+ * return: prefix       address prefix
+ * return: err          error
+ */
+func getMainchainAddressPrefix(mainchainNetworkType NetworkType) (prefix *addressPrefix, err error) {
+	if err = validateEnumTypes(mainchainNetworkType); err == nil && mainchainNetworkType.IsElements() {
+		err = errors.New("Illegal mainchain network type.")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return getAddressPrefix(mainchainNetworkType)
+}
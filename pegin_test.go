@@ -0,0 +1,58 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdGetPeginAddress(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	fedpegScript := "522103774ae7f858a9411e5ef4246b70c65aac5649980be5c17891bbec17895da008cb2103d01115d548e7561b15c38f004d734633687cf4419620095bc5b0f47070afe85a2103f28773c2d975288bc7d1d205c3748651b075fbc6610e58cddeeddf8f19405aa853ae"
+	pubkey := "025cbdf0646e5db4eaa398f365f2ea7a0e3d419b7e0330e39ce92bddedcac4f9bc"
+	claimScript := "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69"
+	tweakedFedpegScript := "5221021ce5a818e70ce2ab69d3c53342ba6fdc55e17d2720c884f1a6d3adb5eb0856512102c467f86e669e5b64705f947729a9c7b010293d5da193f417438c3f22805e77c12102f87dbc731902789503781ee1b434b3b30bb84a086713d323499a992824156af053ae"
+
+	peginAddress, script, tweakedScript, err := CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, fedpegScript, KCfdP2shP2wsh, pubkey, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "2MsZDwdDV8t3gnpkc2Q2cMe21Yss7k53kSH", peginAddress)
+	assert.Equal(t, claimScript, script)
+	assert.Equal(t, tweakedFedpegScript, tweakedScript)
+
+	peginAddress, script, tweakedScript, err = CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, fedpegScript, KCfdP2wsh, pubkey, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "bcrt1qlnnvvxl56mzppu7nhwn2n3smrhf5q33seykg2u8dj9cn4ncpp98shnrzut", peginAddress)
+	assert.Equal(t, claimScript, script)
+	assert.Equal(t, tweakedFedpegScript, tweakedScript)
+
+	// OP_PUSHDATA1 pubkey is tweaked to a direct push
+	peginAddress, script, tweakedScript, err = CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, "524c21"+fedpegScript[4:], KCfdP2wsh, pubkey, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "bcrt1qlnnvvxl56mzppu7nhwn2n3smrhf5q33seykg2u8dj9cn4ncpp98shnrzut", peginAddress)
+	assert.Equal(t, tweakedFedpegScript, tweakedScript)
+
+	// liquid watchman script (emergency keys are not tweaked)
+	peginAddress, script, tweakedScript, err = CfdGoGetPeginAddress(handle, KCfdNetworkMainnet,
+		"74518763512103774ae7f858a9411e5ef4246b70c65aac5649980be5c17891bbec17895da008cb51ae67029001b275512103d01115d548e7561b15c38f004d734633687cf4419620095bc5b0f47070afe85a51ae68",
+		KCfdP2shP2wsh, pubkey, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "3E7kYw2PPRBxtXnAHRappLqJnTJjTJ2DNK", peginAddress)
+	assert.Equal(t, claimScript, script)
+	assert.Equal(t, "745187635121021ce5a818e70ce2ab69d3c53342ba6fdc55e17d2720c884f1a6d3adb5eb08565151ae67029001b275512103d01115d548e7561b15c38f004d734633687cf4419620095bc5b0f47070afe85a51ae68", tweakedScript)
+
+	_, _, _, err = CfdGoGetPeginAddress(handle, KCfdNetworkLiquidv1, fedpegScript, KCfdP2shP2wsh, pubkey, "")
+	assert.Error(t, err)
+	_, _, _, err = CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, fedpegScript, KCfdP2wpkh, pubkey, "")
+	assert.Error(t, err)
+	_, _, _, err = CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, "51", KCfdP2shP2wsh, pubkey, "")
+	assert.Error(t, err)
+	_, _, _, err = CfdGoGetPeginAddress(handle, KCfdNetworkRegtest, fedpegScript, KCfdP2shP2wsh, "", "")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGetPeginAddress test done.\n")
+}
@@ -0,0 +1,101 @@
+package cfdgo
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+/**
+ * Pegout data struct.
+ */
+type CfdPegoutData struct {
+	// mainchain genesis block hash
+	MainchainGenesisBlockHash string
+	// mainchain locking script hex
+	MainchainLockingScript string
+	// mainchain address (empty if the locking script has no address)
+	MainchainAddress string
+	// online pubkey hex (PAK only)
+	OnlinePubkey string
+	// whitelist proof hex (PAK only)
+	WhitelistProof string
+}
+
+/**
+ * Get mainchain network type of the elements network.
+ * detail: liquidv1 uses mainnet, other uses regtest. (same as elements default parent chain)
+ * param: networkType  elements network type
+ * return: mainchainNetworkType  mainchain network type
+ */
+func getPegoutMainchainNetworkType(networkType NetworkType) (mainchainNetworkType NetworkType) {
+	if networkType == KCfdNetworkLiquidv1 {
+		return KCfdNetworkMainnet
+	}
+	return KCfdNetworkRegtest
+}
+
+/**
+ * Validate pegout network types.
+ * param: mainchainNetworkType  mainchain network type (mainnet, testnet, regtest)
+ * param: elementsNetworkType   elements network type (liquidv1, elements regtest)
+ * return: err                  error
+ */
+func validatePegoutNetworkTypes(mainchainNetworkType NetworkType, elementsNetworkType NetworkType) (err error) {
+	if _, err = getMainchainAddressPrefix(mainchainNetworkType); err != nil {
+		return err
+	}
+	if err = validateEnumTypes(elementsNetworkType); err == nil && !elementsNetworkType.IsElements() {
+		err = errors.New("Illegal elements network type.")
+	}
+	return err
+}
+
+/**
+ * Parse pegout locking script. (same as elements IsPegoutScript)
+ * detail: OP_RETURN <genesis block hash> <mainchain locking script> [<online pubkey> <whitelist proof>]
+ *         the script items are parsed by cfd.
+ * param: handle         cfd handle
+ * param: lockingScript  locking script hex
+ * return: data          pegout data (without mainchain address)
+ * return: isPegout      pegout script flag
+ */
+func parsePegoutLockingScript(handle uintptr, lockingScript string) (data *CfdPegoutData, isPegout bool) {
+	items, err := CfdGoParseScript(handle, lockingScript)
+	if err != nil || len(items) < 3 || items[0] != hex.EncodeToString([]byte{opReturn}) {
+		return nil, false
+	}
+	genesisBlockHash, err := hex.DecodeString(items[1])
+	if err != nil || len(genesisBlockHash) != 32 {
+		return nil, false
+	}
+	data = &CfdPegoutData{
+		MainchainGenesisBlockHash: encodeTxid(genesisBlockHash),
+		MainchainLockingScript:    items[2],
+	}
+	if len(items) >= 5 && len(items[3]) == 66 {
+		data.OnlinePubkey = items[3]
+		data.WhitelistProof = items[4]
+	}
+	return data, true
+}
+
+/**
+ * Decode pegout locking script.
+ * param: handle                cfd handle
+ * param: lockingScript         pegout locking script hex
+ * param: mainchainNetworkType  mainchain network type (for mainchain address)
+ * return: data                 pegout data
+ * return: err                  error
+ */
+func CfdGoDecodePegoutLockingScript(handle uintptr, lockingScript string, mainchainNetworkType NetworkType) (data CfdPegoutData, err error) {
+	prefix, err := getMainchainAddressPrefix(mainchainNetworkType)
+	if err != nil {
+		return data, convertGoError(err, "CfdGoDecodePegoutLockingScript")
+	}
+	pegout, isPegout := parsePegoutLockingScript(handle, lockingScript)
+	if !isPegout {
+		return data, convertGoError(errors.New("Not pegout locking script."), "CfdGoDecodePegoutLockingScript")
+	}
+	pegout.MainchainAddress, _ = CfdGoGetAddressFromLockingScript(handle, pegout.MainchainLockingScript, prefix.networkType)
+	return *pegout, nil
+}
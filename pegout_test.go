@@ -0,0 +1,90 @@
+package cfdgo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfdGetPegoutAddress(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	xpub := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	addressTypes := []AddressType{KCfdP2pkhAddress, KCfdP2wpkhAddress, KCfdP2shP2wpkhAddress}
+	hashTypes := []HashType{KCfdP2pkh, KCfdP2wpkh, KCfdP2shP2wpkh}
+	for i, addressType := range addressTypes {
+		address, baseDescriptor, err := CfdGoGetPegoutAddress(handle, KCfdNetworkMainnet, KCfdNetworkLiquidv1, xpub, uint32(0), addressType)
+		assert.NoError(t, err)
+		assert.NotEqual(t, "", baseDescriptor)
+		data, err := CfdGoGetAddressInfo(handle, address)
+		assert.NoError(t, err)
+		assert.Equal(t, KCfdNetworkMainnet, data.NetworkType)
+		assert.Equal(t, hashTypes[i], data.HashType)
+
+		// the bip32 counter selects the derived key.
+		nextAddress, _, err := CfdGoGetPegoutAddress(handle, KCfdNetworkMainnet, KCfdNetworkLiquidv1, xpub, uint32(1), addressType)
+		assert.NoError(t, err)
+		assert.NotEqual(t, address, nextAddress)
+	}
+
+	// illegal mainchain network
+	_, _, err = CfdGoGetPegoutAddress(handle, KCfdNetworkLiquidv1, KCfdNetworkLiquidv1, xpub, uint32(0), KCfdP2pkhAddress)
+	assert.Error(t, err)
+	// illegal elements network
+	_, _, err = CfdGoGetPegoutAddress(handle, KCfdNetworkMainnet, KCfdNetworkMainnet, xpub, uint32(0), KCfdP2pkhAddress)
+	assert.Error(t, err)
+	// illegal address type
+	_, _, err = CfdGoGetPegoutAddress(handle, KCfdNetworkMainnet, KCfdNetworkLiquidv1, xpub, uint32(0), AddressType(-1))
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdGetPegoutAddress test done.\n")
+}
+
+func TestCfdDecodePegoutLockingScript(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	genesisBlockHash := "0f9188f13cb7b2c71f2a335e3a4fc328bf5beb436012afca590b1a11466e2206"
+	address := "bcrt1qthklh702txwafc72d2qtxv7ywt7sk0mfv7esk7"
+	onlinePubkey := "03774ae7f858a9411e5ef4246b70c65aac5649980be5c17891bbec17895da008cb"
+	whitelistProof := "011111111111111111111111111111111111111111111111111111111111111111"
+
+	data, err := CfdGoDecodePegoutLockingScript(handle, "6a2006226e46111a0b59caaf126043eb5bbf28c34f3a5e332a1fc7b2b73cf188910f1600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69", KCfdNetworkRegtest)
+	assert.NoError(t, err)
+	assert.Equal(t, genesisBlockHash, data.MainchainGenesisBlockHash)
+	assert.Equal(t, "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69", data.MainchainLockingScript)
+	assert.Equal(t, address, data.MainchainAddress)
+	assert.Equal(t, "", data.OnlinePubkey)
+	assert.Equal(t, "", data.WhitelistProof)
+
+	// PAK
+	data, err = CfdGoDecodePegoutLockingScript(handle, "6a2006226e46111a0b59caaf126043eb5bbf28c34f3a5e332a1fc7b2b73cf188910f1600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f692103774ae7f858a9411e5ef4246b70c65aac5649980be5c17891bbec17895da008cb21011111111111111111111111111111111111111111111111111111111111111111", KCfdNetworkRegtest)
+	assert.NoError(t, err)
+	assert.Equal(t, genesisBlockHash, data.MainchainGenesisBlockHash)
+	assert.Equal(t, address, data.MainchainAddress)
+	assert.Equal(t, onlinePubkey, data.OnlinePubkey)
+	assert.Equal(t, whitelistProof, data.WhitelistProof)
+
+	// mainnet p2pkh
+	data, err = CfdGoDecodePegoutLockingScript(handle, "6a206fe28c0ab6f1b372c1a6a246ae63f74f931e8365e15a089c68d61900000000001976a9145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f6988ac", KCfdNetworkMainnet)
+	assert.NoError(t, err)
+	assert.Equal(t, "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", data.MainchainGenesisBlockHash)
+	assert.Equal(t, "19ZewH8Kk1PDbSNdJ97FP4EiCjTRaZMZQA", data.MainchainAddress)
+
+	// illegal mainchain network
+	_, err = CfdGoDecodePegoutLockingScript(handle, "6a2006226e46111a0b59caaf126043eb5bbf28c34f3a5e332a1fc7b2b73cf188910f1600145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69", KCfdNetworkElementsRegtest)
+	assert.Error(t, err)
+	// not pegout script
+	_, err = CfdGoDecodePegoutLockingScript(handle, "00145dedfbf9ea599dd4e3ca6a80b333c472fd0b3f69", KCfdNetworkRegtest)
+	assert.Error(t, err)
+	_, err = CfdGoDecodePegoutLockingScript(handle, "6a0100", KCfdNetworkRegtest)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdDecodePegoutLockingScript test done.\n")
+}
@@ -0,0 +1,156 @@
+package cfdgo
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCfdSchnorrSign(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	// BIP340 test vectors (index 0-3)
+	testCases := []struct {
+		privkey   string
+		pubkey    string
+		parity    bool
+		auxRand   string
+		msg       string
+		signature string
+	}{
+		{"0000000000000000000000000000000000000000000000000000000000000003",
+			"f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9", false,
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"e907831f80848d1069a5371b402410364bdf1c5f8307b0084c55f1ce2dca821525f66a4a85ea8b71e482a74f382d2ce5ebeee8fdb2172f477df4900d310536c0"},
+		{"b7e151628aed2a6abf7158809cf4f3c762e7160f38b4da56a784d9045190cfef",
+			"dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659", false,
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+			"6896bd60eeae296db48a229ff71dfe071bde413e6d43f917dc8dcf8c78de33418906d11ac976abccb20b091292bff4ea897efcb639ea871cfa95f6de339e4b0a"},
+		{"c90fdaa22168c234c4c6628b80dc1cd129024e088a67cc74020bbea63b14e5c9",
+			"dd308afec5777e13121fa72b9cc1b7cc0139715309b086c960e18fd969774eb8", false,
+			"c87aa53824b4d7ae2eb035a2b5bbbccc080e76cdc6d1692c4b0b62d798e6d906",
+			"7e2d58d8b3bcdf1abadec7829054f90dda9805aab56c77333024b9d0a508b75c",
+			"5831aaeed7b44bb74e5eab94ba9d4294c49bcf2a60728d8b4c200f50dd313c1bab745879a5ad954a72c45a91c3a51d3c7adea98d82f8481e0e1e03674a6f3fb7"},
+		{"0b432b2677937381aef05bb02a66ecd012773062cf3fa2549e44f58ed2401710",
+			"25d1dff95105f5253c4022f628a996ad3a0d95fbf21d468a1b33f8c160d8f517", true,
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"7eb0509757e246f19449885651611cb965ecc1a187dd51b64fda1edc9637d5ec97582b9cb13db3933705b32ba982af5af25fd78881ebb32771fc5922efc66ea3"},
+	}
+	for _, testCase := range testCases {
+		pubkey, parity, err := CfdGoGetSchnorrPubkeyFromPrivkey(handle, testCase.privkey)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.pubkey, pubkey)
+		assert.Equal(t, testCase.parity, parity)
+
+		compressedPubkey := "02" + testCase.pubkey
+		if testCase.parity {
+			compressedPubkey = "03" + testCase.pubkey
+		}
+		pubkey, parity, err = CfdGoGetSchnorrPubkeyFromPubkey(handle, compressedPubkey)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.pubkey, pubkey)
+		assert.Equal(t, testCase.parity, parity)
+
+		signature, err := CfdGoSignSchnorr(handle, testCase.msg, testCase.privkey, testCase.auxRand)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.signature, signature)
+
+		isVerify, err := CfdGoVerifySchnorr(handle, testCase.signature, testCase.msg, testCase.pubkey)
+		assert.NoError(t, err)
+		assert.True(t, isVerify)
+	}
+
+	// empty aux rand is zero bytes (BIP340 test vector 0)
+	signature, err := CfdGoSignSchnorr(handle, testCases[0].msg, testCases[0].privkey, "")
+	assert.NoError(t, err)
+	assert.Equal(t, testCases[0].signature, signature)
+
+	// BIP340 test vector 4
+	isVerify, err := CfdGoVerifySchnorr(handle,
+		"00000000000000000000003b78ce563f89a0ed9414f5aa28ad0d96d6795f9c6376afb1548af603b3eb45c9f8207dee1060cb71c04e80f593060b07d28308d7f4",
+		"4df3c3f68fcc83b27e9d42c90431a72499f17875c81a599b566c9889b9696703",
+		"d69c3509bb99e412e68b0fe8544e72837dfa30746d8be2aa65975f29d22dc7b9")
+	assert.NoError(t, err)
+	assert.True(t, isVerify)
+	// BIP340 test vector 5 (public key not on the curve)
+	isVerify, _ = CfdGoVerifySchnorr(handle,
+		"6cff5c3ba86c69ea4b7376f31a9bcb4f74c1976089b2d9963da2e5543e17776969e89b4c5564d00349106b8497785dd7d1d713a8ae82b32fa79d5f7fc407d39b",
+		"243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+		"eefdea4cdb677750a420fee807eacf21eb9898ae79b9768766e4faa04a2d4a34")
+	assert.False(t, isVerify)
+	// BIP340 test vector 6 (has_even_y(R) is false)
+	isVerify, err = CfdGoVerifySchnorr(handle,
+		"fff97bd5755eeea420453a14355235d382f6472f8568a18b2f057a14602975563cc27944640ac607cd107ae10923d9ef7a73c643e166be5ebeafa34b1ac553e2",
+		"243f6a8885a308d313198a2e03707344a4093822299f31d0082efa98ec4e6c89",
+		"dff1d77f2a671c5f36183726db2341be58feae1da2deced843240f7b502ba659")
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+	// other message
+	isVerify, err = CfdGoVerifySchnorr(handle, testCases[1].signature, testCases[2].msg, testCases[1].pubkey)
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+
+	// error
+	_, err = CfdGoSignSchnorr(handle, testCases[0].msg,
+		"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", testCases[0].auxRand)
+	assert.Error(t, err)
+	_, err = CfdGoSignSchnorr(handle, "00", testCases[0].privkey, testCases[0].auxRand)
+	assert.Error(t, err)
+	_, err = CfdGoVerifySchnorr(handle, testCases[0].signature, testCases[0].msg, "02"+testCases[0].pubkey)
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdSchnorrSign test done.\n")
+}
+
+func TestCfdSchnorrTweak(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	// BIP341 wallet test vector (scriptPubKey 0: no script tree)
+	internalPubkey := "d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d"
+	tweak := "b86e7be8f39bab32a6f2c0443abbc210f0edac0e2c53d501b36b64437d9c6c70"
+	tweakedPubkey, tweakParity, err := CfdGoSchnorrPubkeyTweakAdd(handle, internalPubkey, tweak)
+	assert.NoError(t, err)
+	assert.Equal(t, "53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343", tweakedPubkey)
+	assert.True(t, tweakParity)
+
+	isValid, err := CfdGoCheckTweakAddFromSchnorrPubkey(handle, tweakedPubkey, tweakParity, internalPubkey, tweak)
+	assert.NoError(t, err)
+	assert.True(t, isValid)
+	isValid, err = CfdGoCheckTweakAddFromSchnorrPubkey(handle, tweakedPubkey, false, internalPubkey, tweak)
+	assert.NoError(t, err)
+	assert.False(t, isValid)
+
+	// tweaked privkey matches tweaked pubkey. (BIP340 test vector keys: even y and odd y)
+	privkeys := []string{
+		"b7e151628aed2a6abf7158809cf4f3c762e7160f38b4da56a784d9045190cfef",
+		"0b432b2677937381aef05bb02a66ecd012773062cf3fa2549e44f58ed2401710",
+	}
+	for _, privkey := range privkeys {
+		pubkey, _, err := CfdGoGetSchnorrPubkeyFromPrivkey(handle, privkey)
+		assert.NoError(t, err)
+		tweakedPubkey, tweakParity, err := CfdGoSchnorrPubkeyTweakAdd(handle, pubkey, tweak)
+		assert.NoError(t, err)
+		tweakedPrivkey, err := CfdGoSchnorrPrivkeyTweakAdd(handle, privkey, tweak)
+		assert.NoError(t, err)
+		keyFromPrivkey, parity, err := CfdGoGetSchnorrPubkeyFromPrivkey(handle, tweakedPrivkey)
+		assert.NoError(t, err)
+		assert.Equal(t, tweakedPubkey, keyFromPrivkey)
+		assert.Equal(t, tweakParity, parity)
+	}
+
+	// tweak is out of range
+	_, _, err = CfdGoSchnorrPubkeyTweakAdd(handle, internalPubkey,
+		"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdSchnorrTweak test done.\n")
+}
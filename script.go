@@ -0,0 +1,354 @@
+package cfdgo
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// script opcodes
+const (
+	opPushData1     byte = 0x4c
+	opPushData2     byte = 0x4d
+	opPushData4     byte = 0x4e
+	op1             byte = 0x51
+	op16            byte = 0x60
+	opReturn        byte = 0x6a
+	opCheckSig      byte = 0xac
+	opCheckMultiSig byte = 0xae
+)
+
+// script type names (same as bitcoind)
+const (
+	scriptTypeNonstandard         = "nonstandard"
+	scriptTypePubkey              = "pubkey"
+	scriptTypePubkeyHash          = "pubkeyhash"
+	scriptTypeScriptHash          = "scripthash"
+	scriptTypeMultisig            = "multisig"
+	scriptTypeNullData            = "nulldata"
+	scriptTypeWitnessV0KeyHash    = "witness_v0_keyhash"
+	scriptTypeWitnessV0ScriptHash = "witness_v0_scripthash"
+	scriptTypeWitnessV1Taproot    = "witness_v1_taproot"
+	scriptTypeWitnessUnknown      = "witness_unknown"
+	scriptTypeFee                 = "fee"
+)
+
+var opcodeNames = map[byte]string{
+	0x00: "0", 0x4c: "OP_PUSHDATA1", 0x4d: "OP_PUSHDATA2", 0x4e: "OP_PUSHDATA4",
+	0x4f: "-1", 0x50: "OP_RESERVED",
+	0x61: "OP_NOP", 0x62: "OP_VER", 0x63: "OP_IF", 0x64: "OP_NOTIF",
+	0x65: "OP_VERIF", 0x66: "OP_VERNOTIF", 0x67: "OP_ELSE", 0x68: "OP_ENDIF",
+	0x69: "OP_VERIFY", 0x6a: "OP_RETURN",
+	0x6b: "OP_TOALTSTACK", 0x6c: "OP_FROMALTSTACK", 0x6d: "OP_2DROP", 0x6e: "OP_2DUP",
+	0x6f: "OP_3DUP", 0x70: "OP_2OVER", 0x71: "OP_2ROT", 0x72: "OP_2SWAP",
+	0x73: "OP_IFDUP", 0x74: "OP_DEPTH", 0x75: "OP_DROP", 0x76: "OP_DUP",
+	0x77: "OP_NIP", 0x78: "OP_OVER", 0x79: "OP_PICK", 0x7a: "OP_ROLL",
+	0x7b: "OP_ROT", 0x7c: "OP_SWAP", 0x7d: "OP_TUCK",
+	0x7e: "OP_CAT", 0x7f: "OP_SUBSTR", 0x80: "OP_LEFT", 0x81: "OP_RIGHT", 0x82: "OP_SIZE",
+	0x83: "OP_INVERT", 0x84: "OP_AND", 0x85: "OP_OR", 0x86: "OP_XOR",
+	0x87: "OP_EQUAL", 0x88: "OP_EQUALVERIFY", 0x89: "OP_RESERVED1", 0x8a: "OP_RESERVED2",
+	0x8b: "OP_1ADD", 0x8c: "OP_1SUB", 0x8d: "OP_2MUL", 0x8e: "OP_2DIV",
+	0x8f: "OP_NEGATE", 0x90: "OP_ABS", 0x91: "OP_NOT", 0x92: "OP_0NOTEQUAL",
+	0x93: "OP_ADD", 0x94: "OP_SUB", 0x95: "OP_MUL", 0x96: "OP_DIV",
+	0x97: "OP_MOD", 0x98: "OP_LSHIFT", 0x99: "OP_RSHIFT",
+	0x9a: "OP_BOOLAND", 0x9b: "OP_BOOLOR", 0x9c: "OP_NUMEQUAL", 0x9d: "OP_NUMEQUALVERIFY",
+	0x9e: "OP_NUMNOTEQUAL", 0x9f: "OP_LESSTHAN", 0xa0: "OP_GREATERTHAN",
+	0xa1: "OP_LESSTHANOREQUAL", 0xa2: "OP_GREATERTHANOREQUAL",
+	0xa3: "OP_MIN", 0xa4: "OP_MAX", 0xa5: "OP_WITHIN",
+	0xa6: "OP_RIPEMD160", 0xa7: "OP_SHA1", 0xa8: "OP_SHA256", 0xa9: "OP_HASH160",
+	0xaa: "OP_HASH256", 0xab: "OP_CODESEPARATOR", 0xac: "OP_CHECKSIG",
+	0xad: "OP_CHECKSIGVERIFY", 0xae: "OP_CHECKMULTISIG", 0xaf: "OP_CHECKMULTISIGVERIFY",
+	0xb0: "OP_NOP1", 0xb1: "OP_CHECKLOCKTIMEVERIFY", 0xb2: "OP_CHECKSEQUENCEVERIFY",
+	0xb3: "OP_NOP4", 0xb4: "OP_NOP5", 0xb5: "OP_NOP6", 0xb6: "OP_NOP7",
+	0xb7: "OP_NOP8", 0xb8: "OP_NOP9", 0xb9: "OP_NOP10",
+	// elements
+	0xc0: "OP_DETERMINISTICRANDOM", 0xc1: "OP_CHECKSIGFROMSTACK", 0xc2: "OP_CHECKSIGFROMSTACKVERIFY",
+	0xff: "OP_INVALIDOPCODE",
+}
+
+var sighashTypeNames = map[byte]string{
+	0x01: "ALL", 0x81: "ALL|ANYONECANPAY",
+	0x02: "NONE", 0x82: "NONE|ANYONECANPAY",
+	0x03: "SINGLE", 0x83: "SINGLE|ANYONECANPAY",
+}
+
+/**
+ * Script operation struct.
+ */
+type scriptOperation struct {
+	opcode byte
+	data   []byte
+}
+
+/**
+ * Get opcode name.
+ * param: opcode       opcode
+ * return: name        opcode name
+ */
+func getOpcodeName(opcode byte) string {
+	if opcode >= op1 && opcode <= op16 {
+		return strconv.Itoa(int(opcode-op1) + 1)
+	}
+	if name, ok := opcodeNames[opcode]; ok {
+		return name
+	}
+	return "OP_UNKNOWN"
+}
+
+/**
+ * Parse script to operation list.
+ * param: script       script bytes
+ * return: operations  operation list (parsed until error)
+ * return: err         error
+ */
+func parseScriptOperations(script []byte) (operations []scriptOperation, err error) {
+	offset := 0
+	for offset < len(script) {
+		opcode := script[offset]
+		offset++
+		if opcode > opPushData4 {
+			operations = append(operations, scriptOperation{opcode: opcode})
+			continue
+		}
+		size := int(opcode)
+		switch opcode {
+		case opPushData1:
+			if offset+1 > len(script) {
+				return operations, errors.New("Invalid script push data.")
+			}
+			size = int(script[offset])
+			offset++
+		case opPushData2:
+			if offset+2 > len(script) {
+				return operations, errors.New("Invalid script push data.")
+			}
+			size = int(binary.LittleEndian.Uint16(script[offset:]))
+			offset += 2
+		case opPushData4:
+			if offset+4 > len(script) {
+				return operations, errors.New("Invalid script push data.")
+			}
+			size = int(binary.LittleEndian.Uint32(script[offset:]))
+			offset += 4
+		}
+		if size < 0 || offset+size > len(script) {
+			return operations, errors.New("Invalid script push data.")
+		}
+		operations = append(operations, scriptOperation{opcode: opcode, data: script[offset : offset+size]})
+		offset += size
+	}
+	return operations, nil
+}
+
+/**
+ * Decode script number. (minimal encoding is not checked)
+ * param: data         script number bytes (max 4 bytes)
+ * return: value       number
+ */
+func decodeScriptNumber(data []byte) int64 {
+	if len(data) == 0 {
+		return 0
+	}
+	value := int64(0)
+	for i, b := range data {
+		value |= int64(b) << uint(8*i)
+	}
+	if data[len(data)-1]&0x80 != 0 {
+		return -(value & ^(int64(0x80) << uint(8*(len(data)-1))))
+	}
+	return value
+}
+
+/**
+ * Check der signature encoding. (BIP66)
+ * param: signature    signature with sighash type byte
+ * return: isValid     valid flag
+ */
+func isValidDerSignatureEncoding(signature []byte) bool {
+	size := len(signature)
+	if size < 9 || size > 73 || signature[0] != 0x30 || int(signature[1]) != size-3 {
+		return false
+	}
+	lenR := int(signature[3])
+	if 5+lenR >= size {
+		return false
+	}
+	lenS := int(signature[5+lenR])
+	if lenR+lenS+7 != size {
+		return false
+	}
+	if signature[2] != 0x02 || lenR == 0 || signature[4]&0x80 != 0 {
+		return false
+	}
+	if lenR > 1 && signature[4] == 0x00 && signature[5]&0x80 == 0 {
+		return false
+	}
+	if signature[lenR+4] != 0x02 || lenS == 0 || signature[lenR+6]&0x80 != 0 {
+		return false
+	}
+	if lenS > 1 && signature[lenR+6] == 0x00 && signature[lenR+7]&0x80 == 0 {
+		return false
+	}
+	return true
+}
+
+/**
+ * Convert script to asm string. (same as bitcoind)
+ * param: script                script bytes
+ * param: attemptSighashDecode  decode sighash type of signature
+ * return: asm                  asm string
+ */
+func scriptToAsm(script []byte, attemptSighashDecode bool) string {
+	operations, err := parseScriptOperations(script)
+	isUnspendable := len(script) > 0 && script[0] == opReturn
+	items := make([]string, 0, len(operations)+1)
+	for _, operation := range operations {
+		if operation.opcode > opPushData4 {
+			items = append(items, getOpcodeName(operation.opcode))
+		} else if len(operation.data) <= 4 {
+			items = append(items, strconv.FormatInt(decodeScriptNumber(operation.data), 10))
+		} else if attemptSighashDecode && !isUnspendable && isValidDerSignatureEncoding(operation.data) {
+			sighashByte := operation.data[len(operation.data)-1]
+			if name, ok := sighashTypeNames[sighashByte]; ok {
+				items = append(items, hex.EncodeToString(operation.data[:len(operation.data)-1])+"["+name+"]")
+			} else {
+				items = append(items, hex.EncodeToString(operation.data))
+			}
+		} else {
+			items = append(items, hex.EncodeToString(operation.data))
+		}
+	}
+	if err != nil {
+		items = append(items, "[error]")
+	}
+	return strings.Join(items, " ")
+}
+
+/**
+ * Check pubkey size by header byte.
+ * param: pubkey       pubkey bytes
+ * return: isValid     valid size flag
+ */
+func isValidPubkeySize(pubkey []byte) bool {
+	if len(pubkey) == 0 {
+		return false
+	}
+	switch pubkey[0] {
+	case 0x02, 0x03:
+		return len(pubkey) == 33
+	case 0x04, 0x06, 0x07:
+		return len(pubkey) == 65
+	default:
+		return false
+	}
+}
+
+/**
+ * Check pubkey is valid. (point on the curve)
+ * param: handle       cfd handle
+ * param: pubkey       pubkey bytes (compressed or uncompressed)
+ * return: isValid     valid flag
+ */
+func isValidPubkey(handle uintptr, pubkey []byte) bool {
+	if !isValidPubkeySize(pubkey) {
+		return false
+	}
+	_, err := CfdGoCompressPubkey(handle, hex.EncodeToString(pubkey))
+	return err == nil
+}
+
+/**
+ * Locking script information struct.
+ */
+type lockingScriptInfo struct {
+	scriptType string
+	addresses  []string
+	reqSigs    int
+}
+
+/**
+ * Get locking script information. (script type names are same as bitcoind)
+ * detail: the address and its hash type are resolved by cfd.
+ *         the pubkey and multisig scripts are matched with the script items of cfd.
+ * param: handle         cfd handle
+ * param: lockingScript  locking script hex
+ * param: networkType    network type of the addresses
+ * return: info          locking script information
+ */
+func getLockingScriptInfo(handle uintptr, lockingScript string, networkType NetworkType) (info *lockingScriptInfo) {
+	info = &lockingScriptInfo{scriptType: scriptTypeNonstandard}
+	if lockingScript == "" {
+		info.scriptType = scriptTypeFee
+		return info
+	}
+	// the script type does not depend on the address format of the custom network.
+	nativeNetworkType := getNativeNetworkType(networkType)
+	toNetworkAddresses := func(nativeAddresses ...string) (addresses []string) {
+		for _, address := range nativeAddresses {
+			if isCustomNetwork(networkType) {
+				var err error
+				if address, err = toCustomNetworkAddress(address); err != nil {
+					continue
+				}
+			}
+			addresses = append(addresses, address)
+		}
+		return addresses
+	}
+
+	if address, err := CfdGoGetAddressFromLockingScript(handle, lockingScript, nativeNetworkType); err == nil {
+		if data, err := CfdGoGetAddressInfo(handle, address); err == nil {
+			switch {
+			case data.WitnessVersion > 0 && data.HashType != KCfdTaproot:
+				info.scriptType = scriptTypeWitnessUnknown
+			case data.HashType == KCfdP2pkh:
+				info.scriptType = scriptTypePubkeyHash
+			case data.HashType == KCfdP2sh:
+				info.scriptType = scriptTypeScriptHash
+			case data.HashType == KCfdP2wpkh:
+				info.scriptType = scriptTypeWitnessV0KeyHash
+			case data.HashType == KCfdP2wsh:
+				info.scriptType = scriptTypeWitnessV0ScriptHash
+			case data.HashType == KCfdTaproot:
+				info.scriptType = scriptTypeWitnessV1Taproot
+			}
+			info.addresses = toNetworkAddresses(address)
+			info.reqSigs = 1
+			return info
+		}
+	}
+
+	items, err := CfdGoParseScript(handle, lockingScript)
+	if err != nil || len(items) == 0 {
+		return info
+	}
+	// cfd returns the opcodes as hex.
+	isOpcode := func(item string, opcode byte) bool {
+		return item == hex.EncodeToString([]byte{opcode})
+	}
+	switch {
+	case isOpcode(items[0], opReturn):
+		info.scriptType = scriptTypeNullData
+	case len(items) == 2 && isOpcode(items[1], opCheckSig):
+		address, _, _, err := CfdGoCreateAddress(handle, KCfdP2pkh, items[0], "", nativeNetworkType)
+		if err == nil {
+			info.scriptType = scriptTypePubkey
+			info.addresses = toNetworkAddresses(address)
+			info.reqSigs = 1
+		}
+	case len(items) >= 4 && isOpcode(items[len(items)-1], opCheckMultiSig):
+		requireOpcode, numErr := hex.DecodeString(items[0])
+		if numErr == nil && (len(requireOpcode) != 1 || requireOpcode[0] < op1 || requireOpcode[0] > op16) {
+			numErr = errors.New("Invalid require signature num.")
+		}
+		addresses, _, err := CfdGoGetAddressesFromMultisig(handle, lockingScript, nativeNetworkType, KCfdP2pkh)
+		if numErr == nil && err == nil {
+			info.scriptType = scriptTypeMultisig
+			info.addresses = toNetworkAddresses(addresses...)
+			info.reqSigs = int(requireOpcode[0]-op1) + 1
+		}
+	}
+	return info
+}
@@ -0,0 +1,106 @@
+package cfdgo
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCfdVerifyEcSignature(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	sighash := "e5b11ddceab1e4fc49a8132ae589a39b07acf49cabb2b0fbf6104bc31da12c02"
+	pubkey := "034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa"
+	uncompressedPubkey := "044f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa385b6b1b8ead809ca67454d9683fcf2ba03456d6fe2c4abe2b07f0fbdbb2f1c1"
+	signature := "bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe39588358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f"
+	highSSignature := "bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe39588ca78ac23715cfefd8d112605f1108242eec65ccb7120eaac396a47e4ae996912"
+
+	compressedPubkey, err := CfdGoCompressPubkey(handle, uncompressedPubkey)
+	assert.NoError(t, err)
+	assert.Equal(t, pubkey, compressedPubkey)
+
+	isVerify, err := CfdGoVerifyEcSignature(handle, sighash, pubkey, signature)
+	assert.NoError(t, err)
+	assert.True(t, isVerify)
+	isVerify, err = CfdGoVerifyEcSignature(handle, sighash, uncompressedPubkey, signature)
+	assert.NoError(t, err)
+	assert.True(t, isVerify)
+	// other pubkey
+	isVerify, err = CfdGoVerifyEcSignature(handle, sighash,
+		"02466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f27", signature)
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+	// other sighash
+	isVerify, err = CfdGoVerifyEcSignature(handle,
+		"f5b11ddceab1e4fc49a8132ae589a39b07acf49cabb2b0fbf6104bc31da12c02", pubkey, signature)
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+	// high-S is rejected
+	isVerify, err = CfdGoVerifyEcSignature(handle, sighash, pubkey, highSSignature)
+	assert.NoError(t, err)
+	assert.False(t, isVerify)
+
+	normalizedSignature, err := CfdGoNormalizeSignature(handle, highSSignature)
+	assert.NoError(t, err)
+	assert.Equal(t, signature, normalizedSignature)
+	normalizedSignature, err = CfdGoNormalizeSignature(handle, signature)
+	assert.NoError(t, err)
+	assert.Equal(t, signature, normalizedSignature)
+
+	// error
+	_, err = CfdGoVerifyEcSignature(handle, sighash, "03", signature)
+	assert.Error(t, err)
+	_, err = CfdGoVerifyEcSignature(handle, sighash, pubkey, signature[:126])
+	assert.Error(t, err)
+	_, err = CfdGoNormalizeSignature(handle, "")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdVerifyEcSignature test done.\n")
+}
+
+func TestCfdDerSignature(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	signature := "bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe39588358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f"
+	derSignature, err := CfdGoEncodeSignatureByDer(handle, signature, KCfdSigHashAll, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "3045022100bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f01", derSignature)
+
+	decodedSignature, sighashType, anyoneCanPay, err := CfdGoDecodeSignatureFromDer(handle, derSignature)
+	assert.NoError(t, err)
+	assert.Equal(t, signature, decodedSignature)
+	assert.Equal(t, KCfdSigHashAll, sighashType)
+	assert.False(t, anyoneCanPay)
+
+	derSignature, err = CfdGoEncodeSignatureByDer(handle, signature, KCfdSigHashSingle, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "3045022100bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f83", derSignature)
+	decodedSignature, sighashType, anyoneCanPay, err = CfdGoDecodeSignatureFromDer(handle, derSignature)
+	assert.NoError(t, err)
+	assert.Equal(t, signature, decodedSignature)
+	assert.Equal(t, KCfdSigHashSingle, sighashType)
+	assert.True(t, anyoneCanPay)
+
+	// negative r value
+	_, _, _, err = CfdGoDecodeSignatureFromDer(handle, "30440220bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f01")
+	assert.Error(t, err)
+	// non-minimal r value
+	_, _, _, err = CfdGoDecodeSignatureFromDer(handle, "304402200027482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f01")
+	assert.Error(t, err)
+	// short r value
+	decodedSignature, _, _, err = CfdGoDecodeSignatureFromDer(handle, "3043021f27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f01")
+	assert.NoError(t, err)
+	assert.Equal(t, "0027482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe39588358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f", decodedSignature)
+
+	// invalid sighash type
+	_, _, _, err = CfdGoDecodeSignatureFromDer(handle, "3045022100bf27482d41f2088b687404034c186c6cc487d15bc396dc361fac0a62fbe395880220358753dc8ea3010272eed9fa0eef7dbbcbe8801b3e27b58f866816a8219cd82f00")
+	assert.Error(t, err)
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdDerSignature test done.\n")
+}
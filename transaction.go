@@ -0,0 +1,567 @@
+package cfdgo
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+)
+
+const (
+	maxSatoshiAmount int64 = 2100000000000000
+)
+
+/**
+ * Bitcoin transaction input struct.
+ */
+type bitcoinTxIn struct {
+	txid      []byte
+	vout      uint32
+	scriptSig []byte
+	sequence  uint32
+	witness   [][]byte
+}
+
+/**
+ * Bitcoin transaction output struct.
+ */
+type bitcoinTxOut struct {
+	amount        int64
+	lockingScript []byte
+}
+
+/**
+ * Bitcoin transaction struct.
+ */
+type bitcoinTx struct {
+	version  uint32
+	txins    []bitcoinTxIn
+	txouts   []bitcoinTxOut
+	locktime uint32
+}
+
+/**
+ * Byte reader for transaction deserialize.
+ */
+type txReader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func (r *txReader) read(size int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if size < 0 || r.offset+size > len(r.data) {
+		r.err = errors.New("Invalid transaction data size.")
+		return nil
+	}
+	data := r.data[r.offset : r.offset+size]
+	r.offset += size
+	return data
+}
+
+func (r *txReader) readUint8() byte {
+	if data := r.read(1); data != nil {
+		return data[0]
+	}
+	return 0
+}
+
+func (r *txReader) readUint32() uint32 {
+	if data := r.read(4); data != nil {
+		return binary.LittleEndian.Uint32(data)
+	}
+	return 0
+}
+
+func (r *txReader) readUint64() uint64 {
+	if data := r.read(8); data != nil {
+		return binary.LittleEndian.Uint64(data)
+	}
+	return 0
+}
+
+func (r *txReader) readVarInt() uint64 {
+	switch head := r.readUint8(); head {
+	case 0xfd:
+		if data := r.read(2); data != nil {
+			return uint64(binary.LittleEndian.Uint16(data))
+		}
+	case 0xfe:
+		return uint64(r.readUint32())
+	case 0xff:
+		return r.readUint64()
+	default:
+		return uint64(head)
+	}
+	return 0
+}
+
+func (r *txReader) readVarBytes() []byte {
+	size := r.readVarInt()
+	if size > uint64(len(r.data)) {
+		r.err = errors.New("Invalid transaction data size.")
+		return nil
+	}
+	return r.read(int(size))
+}
+
+func (r *txReader) readCount() int {
+	count := r.readVarInt()
+	if count > uint64(len(r.data)) {
+		r.err = errors.New("Invalid transaction data count.")
+		return 0
+	}
+	return int(count)
+}
+
+/**
+ * Byte writer for transaction serialize.
+ */
+type txWriter struct {
+	data []byte
+}
+
+func (w *txWriter) write(data []byte) {
+	w.data = append(w.data, data...)
+}
+
+func (w *txWriter) writeUint8(value byte) {
+	w.data = append(w.data, value)
+}
+
+func (w *txWriter) writeUint32(value uint32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], value)
+	w.data = append(w.data, buf[:]...)
+}
+
+func (w *txWriter) writeUint64(value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	w.data = append(w.data, buf[:]...)
+}
+
+func (w *txWriter) writeVarInt(value uint64) {
+	switch {
+	case value < 0xfd:
+		w.writeUint8(byte(value))
+	case value <= 0xffff:
+		w.writeUint8(0xfd)
+		w.data = append(w.data, byte(value), byte(value>>8))
+	case value <= 0xffffffff:
+		w.writeUint8(0xfe)
+		w.writeUint32(uint32(value))
+	default:
+		w.writeUint8(0xff)
+		w.writeUint64(value)
+	}
+}
+
+func (w *txWriter) writeVarBytes(data []byte) {
+	w.writeVarInt(uint64(len(data)))
+	w.write(data)
+}
+
+/**
+ * Decode txid hex. (display byte order to internal byte order)
+ * param: txid         txid hex
+ * return: data        txid bytes
+ * return: err         error
+ */
+func decodeTxid(txid string) (data []byte, err error) {
+	data, err = hex.DecodeString(txid)
+	if err != nil || len(data) != 32 {
+		return nil, errors.New("Invalid txid.")
+	}
+	return reverseBytes(data), nil
+}
+
+/**
+ * Decode fixed size hex string.
+ * param: data         hex string
+ * param: size         byte size
+ * param: errMessage   error message on invalid data
+ * return: bytes       decoded bytes
+ * return: err         error
+ */
+func decodeFixedSizeHex(data string, size int, errMessage string) (bytes []byte, err error) {
+	bytes, err = hex.DecodeString(data)
+	if err != nil || len(bytes) != size {
+		return nil, errors.New(errMessage)
+	}
+	return bytes, nil
+}
+
+/**
+ * Encode txid hex. (internal byte order to display byte order)
+ * param: data         txid bytes
+ * return: txid        txid hex
+ */
+func encodeTxid(data []byte) (txid string) {
+	return hex.EncodeToString(reverseBytes(data))
+}
+
+/**
+ * Reverse byte order.
+ * param: data         data bytes
+ * return: reversed    reversed data bytes (new slice)
+ */
+func reverseBytes(data []byte) (reversed []byte) {
+	reversed = make([]byte, len(data))
+	for i := range data {
+		reversed[len(data)-1-i] = data[i]
+	}
+	return reversed
+}
+
+/**
+ * Check witness data exists.
+ * return: hasWitness  witness exist flag
+ */
+func (tx *bitcoinTx) hasWitness() bool {
+	for _, txin := range tx.txins {
+		if len(txin.witness) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Serialize transaction.
+ * param: withWitness  serialize witness data if exists
+ * return: data        serialized transaction
+ */
+func (tx *bitcoinTx) serialize(withWitness bool) []byte {
+	hasWitness := withWitness && tx.hasWitness()
+	w := &txWriter{}
+	w.writeUint32(tx.version)
+	if hasWitness {
+		w.write([]byte{0x00, 0x01})
+	}
+	w.writeVarInt(uint64(len(tx.txins)))
+	for _, txin := range tx.txins {
+		w.write(txin.txid)
+		w.writeUint32(txin.vout)
+		w.writeVarBytes(txin.scriptSig)
+		w.writeUint32(txin.sequence)
+	}
+	w.writeVarInt(uint64(len(tx.txouts)))
+	for _, txout := range tx.txouts {
+		w.writeUint64(uint64(txout.amount))
+		w.writeVarBytes(txout.lockingScript)
+	}
+	if hasWitness {
+		for _, txin := range tx.txins {
+			w.writeVarInt(uint64(len(txin.witness)))
+			for _, stack := range txin.witness {
+				w.writeVarBytes(stack)
+			}
+		}
+	}
+	w.writeUint32(tx.locktime)
+	return w.data
+}
+
+/**
+ * Serialize transaction to hex.
+ * return: txHex       transaction hex
+ */
+func (tx *bitcoinTx) toHex() string {
+	return hex.EncodeToString(tx.serialize(true))
+}
+
+/**
+ * Parse transaction hex.
+ * param: txHex        transaction hex
+ * return: tx          transaction struct
+ * return: err         error
+ */
+func parseBitcoinTx(txHex string) (tx *bitcoinTx, err error) {
+	data, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, errors.New("Invalid transaction hex.")
+	}
+	// A transaction without txin is ambiguous with the witness marker.
+	// (same as bitcoind decoderawtransaction)
+	if tx, err = deserializeBitcoinTx(data, true); err != nil {
+		if legacyTx, legacyErr := deserializeBitcoinTx(data, false); legacyErr == nil {
+			return legacyTx, nil
+		}
+	}
+	return tx, err
+}
+
+/**
+ * Deserialize transaction.
+ * param: data          transaction bytes
+ * param: allowWitness  allow witness serialization format
+ * return: tx           transaction struct
+ * return: err          error
+ */
+func deserializeBitcoinTx(data []byte, allowWitness bool) (tx *bitcoinTx, err error) {
+	r := &txReader{data: data}
+	tx = &bitcoinTx{}
+	tx.version = r.readUint32()
+	flags := byte(0)
+	txinCount := r.readCount()
+	if txinCount == 0 && allowWitness && r.err == nil {
+		// segwit marker or empty transaction
+		flags = r.readUint8()
+		if flags != 0 {
+			txinCount = r.readCount()
+		}
+	}
+	for i := 0; i < txinCount && r.err == nil; i++ {
+		var txin bitcoinTxIn
+		txin.txid = append([]byte{}, r.read(32)...)
+		txin.vout = r.readUint32()
+		txin.scriptSig = append([]byte{}, r.readVarBytes()...)
+		txin.sequence = r.readUint32()
+		tx.txins = append(tx.txins, txin)
+	}
+	txoutCount := 0
+	if flags != 0 || txinCount != 0 || !allowWitness {
+		txoutCount = r.readCount()
+	}
+	for i := 0; i < txoutCount && r.err == nil; i++ {
+		var txout bitcoinTxOut
+		txout.amount = int64(r.readUint64())
+		txout.lockingScript = append([]byte{}, r.readVarBytes()...)
+		tx.txouts = append(tx.txouts, txout)
+	}
+	if flags&0x01 != 0 {
+		for i := range tx.txins {
+			stackCount := r.readCount()
+			for j := 0; j < stackCount && r.err == nil; j++ {
+				tx.txins[i].witness = append(tx.txins[i].witness, append([]byte{}, r.readVarBytes()...))
+			}
+		}
+		if !tx.hasWitness() && r.err == nil {
+			return nil, errors.New("Invalid witness flag.")
+		}
+	} else if flags != 0 {
+		return nil, errors.New("Unknown transaction optional data.")
+	}
+	tx.locktime = r.readUint32()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.offset != len(data) {
+		return nil, errors.New("Invalid transaction data size.")
+	}
+	return tx, nil
+}
+
+/**
+ * Validate satoshi amount.
+ * param: satoshiAmount  amount by satoshi
+ * return: err           error
+ */
+func validateSatoshiAmount(satoshiAmount int64) (err error) {
+	if satoshiAmount < 0 || satoshiAmount > maxSatoshiAmount {
+		return errors.New("Amount out of range.")
+	}
+	return nil
+}
+
+/**
+ * Update transaction by cfd transaction create handle.
+ * param: handle        cfd handle
+ * param: version       transaction version (used if txHex is empty)
+ * param: txHex         base transaction hex (empty if creating new transaction)
+ * param: update        update function (called with create handle)
+ * return: outputTxHex  output transaction hex
+ * return: err          error
+ */
+func updateTransaction(handle uintptr, version uint32, txHex string, update func(createHandle uintptr) error) (outputTxHex string, err error) {
+	createHandle, err := CfdGoInitializeTransaction(handle, KCfdNetworkMainnet, version, uint32(0), txHex)
+	if err != nil {
+		return "", err
+	}
+	defer CfdGoFreeTransactionHandle(handle, createHandle)
+	if err = update(createHandle); err != nil {
+		return "", err
+	}
+	return CfdGoFinalizeTransaction(handle, createHandle)
+}
+
+/**
+ * Get initialized transaction.
+ * param: handle        cfd handle
+ * param: version       transaction version
+ * param: locktime      locktime
+ * return: txHex        transaction hex
+ * return: err          error
+ */
+func CfdGoInitializeTx(handle uintptr, version uint32, locktime uint32) (txHex string, err error) {
+	createHandle, err := CfdGoInitializeTransaction(handle, KCfdNetworkMainnet, version, locktime, "")
+	if err != nil {
+		return "", err
+	}
+	defer CfdGoFreeTransactionHandle(handle, createHandle)
+	return CfdGoFinalizeTransaction(handle, createHandle)
+}
+
+/**
+ * Add txin to transaction.
+ * param: handle        cfd handle
+ * param: txHex         transaction hex
+ * param: txid          txid
+ * param: vout          vout
+ * param: sequence      sequence
+ * return: outputTxHex  output transaction hex
+ * return: err          error
+ */
+func CfdGoAddTxIn(handle uintptr, txHex string, txid string, vout uint32, sequence uint32) (outputTxHex string, err error) {
+	tx, err := parseBitcoinTx(txHex)
+	if err == nil {
+		if _, findErr := tx.getTxInIndex(txid, vout); findErr == nil {
+			err = errors.New("Txin is already exist.")
+		}
+	}
+	if err != nil {
+		return "", convertGoError(err, "CfdGoAddTxIn")
+	}
+	return updateTransaction(handle, uint32(0), txHex, func(createHandle uintptr) error {
+		return CfdGoAddTransactionInput(handle, createHandle, txid, vout, sequence)
+	})
+}
+
+/**
+ * Add txout to transaction.
+ * param: handle              cfd handle
+ * param: txHex               transaction hex
+ * param: satoshiAmount       amount by satoshi
+ * param: address             destination address
+ * param: directLockingScript  locking script for direct insert.
+ * return: outputTxHex        output transaction hex
+ * return: err                error
+ */
+func CfdGoAddTxOut(handle uintptr, txHex string, satoshiAmount int64, address string, directLockingScript string) (outputTxHex string, err error) {
+	if err = validateSatoshiAmount(satoshiAmount); err != nil {
+		return "", convertGoError(err, "CfdGoAddTxOut")
+	}
+	return updateTransaction(handle, uint32(0), txHex, func(createHandle uintptr) error {
+		return CfdGoAddTransactionOutput(handle, createHandle, satoshiAmount, address, directLockingScript, "")
+	})
+}
+
+/**
+ * Update txout of transaction.
+ * param: handle              cfd handle
+ * param: txHex               transaction hex
+ * param: index               txout index
+ * param: satoshiAmount       amount by satoshi
+ * param: address             destination address
+ * param: directLockingScript  lockingScript for direct insert.
+ * return: outputTxHex        output transaction hex
+ * return: err                error
+ */
+func CfdGoUpdateTxOut(handle uintptr, txHex string, index uint32, satoshiAmount int64, address string, directLockingScript string) (outputTxHex string, err error) {
+	tx, err := parseBitcoinTx(txHex)
+	if err == nil {
+		err = validateSatoshiAmount(satoshiAmount)
+	}
+	if err != nil {
+		return "", convertGoError(err, "CfdGoUpdateTxOut")
+	}
+	if uint64(index) >= uint64(len(tx.txouts)) {
+		return "", &CfdError{
+			Code:      KCfdOutOfRangeError,
+			Message:   "Txout index out of range.",
+			Operation: "CfdGoUpdateTxOut",
+		}
+	}
+	// create the txout on an empty transaction, to use the address handling of cfd.
+	templateTxHex, err := updateTransaction(handle, tx.version, "", func(createHandle uintptr) error {
+		return CfdGoAddTransactionOutput(handle, createHandle, satoshiAmount, address, directLockingScript, "")
+	})
+	if err != nil {
+		return "", err
+	}
+	templateTx, err := parseBitcoinTx(templateTxHex)
+	if err != nil || len(templateTx.txouts) != 1 {
+		return "", &CfdError{Code: KCfdInternalError, Message: "Failed to create txout.", Operation: "CfdGoUpdateTxOut"}
+	}
+	tx.txouts[index] = templateTx.txouts[0]
+	return tx.toHex(), nil
+}
+
+/**
+ * Multisig sign data struct.
+ */
+type CfdMultisigSignData struct {
+	// signature hex (compact signature or der encoded signature)
+	Signature string
+	// encode to der flag
+	IsDerEncode bool
+	// sighash type (use IsDerEncode)
+	SighashType SigHashType
+	// sighash anyone can pay flag (use IsDerEncode)
+	SighashAnyoneCanPay bool
+	// related pubkey (for sorting by multisig script)
+	RelatedPubkey string
+}
+
+/**
+ * Get txin index.
+ * param: txid         txin txid
+ * param: vout         txin vout
+ * return: index       txin index
+ * return: err         error
+ */
+func (tx *bitcoinTx) getTxInIndex(txid string, vout uint32) (index int, err error) {
+	txidBytes, err := decodeTxid(txid)
+	if err != nil {
+		return 0, err
+	}
+	for i, txin := range tx.txins {
+		if txin.vout == vout && string(txin.txid) == string(txidBytes) {
+			return i, nil
+		}
+	}
+	return 0, &CfdError{Code: KCfdIllegalArgumentError, Message: "Txin not found."}
+}
+
+/**
+ * Add multisig sign data to transaction.
+ * param: handle               cfd handle
+ * param: txHex                transaction hex
+ * param: txid                 txin txid
+ * param: vout                 txin vout
+ * param: hashType             hash type (p2sh, p2wsh, p2sh-p2wsh)
+ * param: witnessScript        witness script (p2wsh, p2sh-p2wsh)
+ * param: redeemScript         redeem script (p2sh)
+ * param: signList             multisig sign data list
+ * param: clearStack           cleanup stack
+ * return: outputTxHex         output transaction hex
+ * return: err                 error
+ */
+func CfdGoFinalizeMultisigSign(handle uintptr, txHex string, txid string, vout uint32, hashType HashType, witnessScript string, redeemScript string, signList []CfdMultisigSignData, clearStack bool) (outputTxHex string, err error) {
+	if err = validateEnumTypes(hashType); err != nil {
+		return "", convertGoError(err, "CfdGoFinalizeMultisigSign")
+	}
+	if len(signList) == 0 {
+		return "", convertGoError(errors.New("Sign data is empty."), "CfdGoFinalizeMultisigSign")
+	}
+	multiSignHandle, err := CfdGoInitializeMultisigSign(handle)
+	if err != nil {
+		return "", err
+	}
+	defer CfdGoFreeMultisigSignHandle(handle, multiSignHandle)
+
+	for _, signData := range signList {
+		if signData.IsDerEncode {
+			err = CfdGoAddMultisigSignDataToDer(handle, multiSignHandle, signData.Signature, signData.SighashType, signData.SighashAnyoneCanPay, signData.RelatedPubkey)
+		} else {
+			err = CfdGoAddMultisigSignData(handle, multiSignHandle, signData.Signature, signData.RelatedPubkey)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return CfdGoFinalizeBitcoinMultisigSign(handle, multiSignHandle, txHex, txid, vout, hashType, witnessScript, redeemScript, clearStack)
+}
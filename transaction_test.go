@@ -0,0 +1,262 @@
+package cfdgo
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCfdCreateBitcoinRawTransaction(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	txHex, err := CfdGoInitializeTx(handle, uint32(2), uint32(0))
+	assert.NoError(t, err)
+	assert.Equal(t, "02000000000000000000", txHex)
+
+	if err == nil {
+		txHex, err = CfdGoAddTxIn(
+			handle, txHex,
+			"7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd", 0,
+			uint32(4294967295))
+		assert.NoError(t, err)
+		assert.Equal(t, "0200000001bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0000000000", txHex)
+	}
+
+	if err == nil {
+		_, err = CfdGoAddTxIn(
+			handle, txHex,
+			"7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd", 0,
+			uint32(4294967295))
+		assert.Error(t, err)
+		err = nil
+	}
+
+	if err == nil {
+		txHex, err = CfdGoAddTxOut(
+			handle, txHex, int64(100000000),
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "0200000001bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0100e1f50500000000160014751e76e8199196d454941c45d1b3a323f1433bd600000000", txHex)
+	}
+
+	if err == nil {
+		txHex, err = CfdGoAddTxOut(
+			handle, txHex, int64(1900500000),
+			"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "0200000001bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0200e1f50500000000160014751e76e8199196d454941c45d1b3a323f1433bd620544771000000001976a914751e76e8199196d454941c45d1b3a323f1433bd688ac00000000", txHex)
+	}
+
+	if err == nil {
+		txHex, err = CfdGoUpdateTxOut(
+			handle, txHex, uint32(1), int64(500000), "",
+			"a914751e76e8199196d454941c45d1b3a323f1433bd687")
+		assert.NoError(t, err)
+		assert.Equal(t, "0200000001bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0200e1f50500000000160014751e76e8199196d454941c45d1b3a323f1433bd620a107000000000017a914751e76e8199196d454941c45d1b3a323f1433bd68700000000", txHex)
+	}
+
+	if err == nil {
+		_, err = CfdGoUpdateTxOut(handle, txHex, uint32(2), int64(500000), "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "")
+		assert.True(t, errors.Is(err, ErrOutOfRange))
+		// liquid address is not bitcoin address.
+		_, err = CfdGoAddTxOut(handle, txHex, int64(500000), "Q7wegLt2qMGhm28vch6VTzvpzs8KXvs4X7", "")
+		assert.True(t, errors.Is(err, ErrIllegalArgument))
+		_, err = CfdGoAddTxOut(handle, txHex, int64(-1), "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "")
+		assert.True(t, errors.Is(err, ErrIllegalArgument))
+		err = nil
+	}
+
+	if err != nil {
+		errMsg, _ := CfdGoGetLastErrorMessage(handle)
+		fmt.Print("[error message] " + errMsg + "\n")
+	}
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdCreateBitcoinRawTransaction test done.\n")
+}
+
+func TestCfdParseBitcoinTransaction(t *testing.T) {
+	// no txin, 1 txout
+	txHex := "02000000000100e1f50500000000160014751e76e8199196d454941c45d1b3a323f1433bd600000000"
+	tx, err := parseBitcoinTx(txHex)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, 0, len(tx.txins))
+		assert.Equal(t, 1, len(tx.txouts))
+		assert.Equal(t, txHex, tx.toHex())
+	}
+
+	// segwit
+	txHex = "02000000000101bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffff0100e1f50500000000160014751e76e8199196d454941c45d1b3a323f1433bd602010102020300000000"
+	tx, err = parseBitcoinTx(txHex)
+	assert.NoError(t, err)
+	if err == nil {
+		assert.Equal(t, 1, len(tx.txins))
+		assert.Equal(t, 2, len(tx.txins[0].witness))
+		assert.Equal(t, txHex, tx.toHex())
+	}
+
+	_, err = parseBitcoinTx("0200000000")
+	assert.Error(t, err)
+	fmt.Print("TestCfdParseBitcoinTransaction test done.\n")
+}
+
+func TestCfdBitcoinSighashAndSign(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	kTxData := "0200000003bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740100000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740200000000ffffffff011098020000000000160014751e76e8199196d454941c45d1b3a323f1433bd600000000"
+	txid := "7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd"
+	sigHashType := KCfdSigHashAll
+	// p2pkh
+	pubkey1 := "034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa"
+	// p2wpkh
+	pubkey2 := "02466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f27"
+	// p2sh-p2wpkh
+	pubkey3 := "023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b1"
+
+	sighash, err := CfdGoCreateSighash(
+		handle, kTxData, txid, uint32(0), KCfdP2pkh, pubkey1, "",
+		int64(0), sigHashType, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "70e9217ccb4606eec56518a5aacedd7841878d89dad764361dadc975c30605c3", sighash)
+	sighash, err = CfdGoCreateSighash(
+		handle, kTxData, txid, uint32(1), KCfdP2wpkh, pubkey2, "",
+		int64(60000), sigHashType, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "c06d8cebc885364d43b3035d87eea580fa551f1205a1bda2ab881c8902cb8aa9", sighash)
+	sighash, err = CfdGoCreateSighash(
+		handle, kTxData, txid, uint32(2), KCfdP2shP2wpkh, pubkey3, "",
+		int64(70000), sigHashType, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "41d7cf10875c64f68b4dba631bb953ba2f255d9167eee74db082615c57799614", sighash)
+
+	txHex := kTxData
+	if err == nil {
+		signature := "cb10336d6a5979818caca05efa9c5ebda1528a02887aeb2988cdf237f8560de67abff09674728a57dfedc1d258d82dbba12e021b07e0286e01aff50537c2c6c8"
+		txHex, err = CfdGoAddTxDerSign(handle, txHex, txid, uint32(0), false, signature, sigHashType, false, true)
+		assert.NoError(t, err)
+		txHex, err = CfdGoAddTxSign(handle, txHex, txid, uint32(0), false, pubkey1, false)
+		assert.NoError(t, err)
+	}
+	if err == nil {
+		signature := "437ecb327e2e1d0159bbd08681c8ef4fa92dd0d9d70b39eca99994335c092dd737e0ccc1eba456ffad417088eee932c9bd6a2cc0dfd07ad57e80287c369a9379"
+		txHex, err = CfdGoAddTxDerSign(handle, txHex, txid, uint32(1), true, signature, sigHashType, false, true)
+		assert.NoError(t, err)
+		txHex, err = CfdGoAddTxSign(handle, txHex, txid, uint32(1), true, pubkey2, false)
+		assert.NoError(t, err)
+	}
+	if err == nil {
+		signature := "3e747e8c8349d455bcce1219834c296a08fc0a7ceaae6fadc5ead5cf52a63d4518279b6706add86f8712d20e0e33ef8b5d805ecee1993a6cbbe2369d124218a0"
+		txHex, err = CfdGoAddTxDerSign(handle, txHex, txid, uint32(2), true, signature, sigHashType, false, true)
+		assert.NoError(t, err)
+		txHex, err = CfdGoAddTxSign(handle, txHex, txid, uint32(2), true, pubkey3, false)
+		assert.NoError(t, err)
+		// p2sh-p2wpkh redeem script
+		txHex, err = CfdGoAddTxSign(handle, txHex, txid, uint32(2), false, "00143bc28d6d92d9073fb5e3adf481795eaf446bceed", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "02000000000103bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b06174000000006b483045022100cb10336d6a5979818caca05efa9c5ebda1528a02887aeb2988cdf237f8560de602207abff09674728a57dfedc1d258d82dbba12e021b07e0286e01aff50537c2c6c80121034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aaffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740100000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b0617402000000171600143bc28d6d92d9073fb5e3adf481795eaf446bceedffffffff011098020000000000160014751e76e8199196d454941c45d1b3a323f1433bd600024730440220437ecb327e2e1d0159bbd08681c8ef4fa92dd0d9d70b39eca99994335c092dd7022037e0ccc1eba456ffad417088eee932c9bd6a2cc0dfd07ad57e80287c369a9379012102466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f270247304402203e747e8c8349d455bcce1219834c296a08fc0a7ceaae6fadc5ead5cf52a63d45022018279b6706add86f8712d20e0e33ef8b5d805ecee1993a6cbbe2369d124218a00121023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b100000000", txHex)
+	}
+
+	if err == nil {
+		// txin not found
+		_, err = CfdGoAddTxSign(handle, txHex, txid, uint32(3), true, pubkey1, false)
+		assert.True(t, errors.Is(err, ErrIllegalArgument))
+		// witness v0 sighash requires amount
+		_, err = CfdGoCreateSighash(
+			handle, kTxData, txid, uint32(1), KCfdP2wpkh, pubkey2, "",
+			int64(-1), sigHashType, false)
+		assert.Error(t, err)
+		err = nil
+	}
+
+	if err != nil {
+		errMsg, _ := CfdGoGetLastErrorMessage(handle)
+		fmt.Print("[error message] " + errMsg + "\n")
+	}
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdBitcoinSighashAndSign test done.\n")
+}
+
+func TestCfdBitcoinMultisigSign(t *testing.T) {
+	handle, err := CfdGoCreateHandle()
+	assert.NoError(t, err)
+
+	kTxData := "0200000003bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740000000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740100000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740200000000ffffffff011098020000000000160014751e76e8199196d454941c45d1b3a323f1433bd600000000"
+	txid := "7461b02405414d79e79a5050684a333c922c1136f4bdff5fb94b551394edebbd"
+	sigHashType := KCfdSigHashAll
+	pubkey1 := "034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa"
+	pubkey3 := "023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b1"
+	// 2-of-3
+	multisigScript := "5221034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa2102466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f2721023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b153ae"
+
+	testCases := []struct {
+		hashType      HashType
+		satoshiAmount int64
+		sighash       string
+		signature1    string
+		signature3    string
+	}{
+		{KCfdP2sh, int64(0), "5d26975d93216bff9fe159af4f102d1debe711acd34b79ed71cc20c55cb77377",
+			"4c653bcf49fa4ae38ecfb21c429424e4b82716d4ff054c9295e23978245f0ab74c95393661fbce2dbfebf1747697df72974ee053e9c4df123c5c24663ab835cc",
+			"ef573ffafd382ddb462ab821dd47269e87b230256001b3937fda5f5742618ae75d9417ff010fb346a5ce639a1d64c678e425ff6e74d9dc0a3cfec94b18040ebf"},
+		{KCfdP2wsh, int64(60000), "f3f01430ec7051549f10aa7d530568e040d24ecd0763eefcf8923a790dc51bb4",
+			"f323ba22f971dbef4953f63e6cda501f9ebf605c524ae76666c3854cfbf45dae31d12bfd50e179f3210ff00a02d1237685238589e394af1fb277591efa6a27ed",
+			"d52fa2a77b95134c36559758cc640191a37e518258fbbf8dc83a2b4b1c634045369f592ad69a404e21b88aba867a11948ce1b57e19e1121b7c91137078ec3d54"},
+		{KCfdP2shP2wsh, int64(70000), "d3f2197d0e6db63ccad7d0e42fdb7ebd531c1c33f1206203306e163b3de620be",
+			"d9560d4737f8c98964ed5ad9720f03554495fc338e62a163aeb3b5108d1b8525407f7430e5b99c9c15d81ed473bc5c4a6c6f0dcd09949ad38b14fc00c0756c77",
+			"be771b861c5c48fda7c0d15fbb60563b9f61cc5da4aed51517b8a1649cb0385a5b015c53fac0718f34a0d6fc481bad6b8e255783bfb3241c4cf65e5ad343cbc3"},
+	}
+
+	txHex := kTxData
+	for index, testCase := range testCases {
+		vout := uint32(index)
+		hashType := testCase.hashType
+		sighash, err := CfdGoCreateSighash(
+			handle, kTxData, txid, vout, hashType, "", multisigScript,
+			testCase.satoshiAmount, sigHashType, false)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.sighash, sighash)
+
+		// reverse order (sort by multisig script)
+		signList := []CfdMultisigSignData{
+			{
+				Signature:     testCase.signature3,
+				IsDerEncode:   true,
+				SighashType:   sigHashType,
+				RelatedPubkey: pubkey3,
+			},
+			{
+				Signature:     testCase.signature1,
+				IsDerEncode:   true,
+				SighashType:   sigHashType,
+				RelatedPubkey: pubkey1,
+			},
+		}
+		witnessScript := multisigScript
+		redeemScript := ""
+		if testCase.hashType == KCfdP2sh {
+			witnessScript = ""
+			redeemScript = multisigScript
+		}
+		txHex, err = CfdGoFinalizeMultisigSign(
+			handle, txHex, txid, vout, hashType, witnessScript, redeemScript, signList, true)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, "02000000000103bdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b0617400000000fdfd000047304402204c653bcf49fa4ae38ecfb21c429424e4b82716d4ff054c9295e23978245f0ab702204c95393661fbce2dbfebf1747697df72974ee053e9c4df123c5c24663ab835cc01483045022100ef573ffafd382ddb462ab821dd47269e87b230256001b3937fda5f5742618ae702205d9417ff010fb346a5ce639a1d64c678e425ff6e74d9dc0a3cfec94b18040ebf014c695221034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa2102466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f2721023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b153aeffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740100000000ffffffffbdebed9413554bb95fffbdf436112c923c334a6850509ae7794d410524b061740200000023220020090e494a0afd279cb8de52b66664aed6fde1021cf0fede59088653fbb87dfe46ffffffff011098020000000000160014751e76e8199196d454941c45d1b3a323f1433bd6000400483045022100f323ba22f971dbef4953f63e6cda501f9ebf605c524ae76666c3854cfbf45dae022031d12bfd50e179f3210ff00a02d1237685238589e394af1fb277591efa6a27ed01483045022100d52fa2a77b95134c36559758cc640191a37e518258fbbf8dc83a2b4b1c6340450220369f592ad69a404e21b88aba867a11948ce1b57e19e1121b7c91137078ec3d5401695221034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa2102466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f2721023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b153ae0400483045022100d9560d4737f8c98964ed5ad9720f03554495fc338e62a163aeb3b5108d1b85250220407f7430e5b99c9c15d81ed473bc5c4a6c6f0dcd09949ad38b14fc00c0756c7701483045022100be771b861c5c48fda7c0d15fbb60563b9f61cc5da4aed51517b8a1649cb0385a02205b015c53fac0718f34a0d6fc481bad6b8e255783bfb3241c4cf65e5ad343cbc301695221034f355bdcb7cc0af728ef3cceb9615d90684bb5b2ca5f859ab0f0b704075871aa2102466d7fcae563e5cb09a0d1870bb580344804617879a14949cf22285f1bae3f2721023c72addb4fdf09af94f0c94d7fe92a386a7e70cf8a1d85916386bb2535c7b1b153ae00000000", txHex)
+
+	// unknown pubkey
+	_, err = CfdGoFinalizeMultisigSign(
+		handle, kTxData, txid, uint32(1), KCfdP2wsh, multisigScript, "",
+		[]CfdMultisigSignData{{Signature: testCases[0].signature1, IsDerEncode: true, SighashType: sigHashType, RelatedPubkey: "02200d8510dfcf8e2330c0795c771d1e6064daab2f274ac32a6e2708df9bfa893d"}}, true)
+	assert.True(t, errors.Is(err, ErrIllegalArgument))
+
+	err = CfdGoFreeHandle(handle)
+	assert.NoError(t, err)
+	fmt.Print("TestCfdBitcoinMultisigSign test done.\n")
+}